@@ -0,0 +1,153 @@
+// Package clock implements the time controls games can be played under.
+package clock
+
+import (
+	"errors"
+	"time"
+)
+
+type System byte
+
+const (
+	// Absolute gives each player a fixed amount of time for the whole game.
+	Absolute System = iota
+	// Fischer adds an increment to the main time after every move, up to an
+	// optional cap.
+	Fischer
+	// ByoYomi follows the main time with periods that are only used up by
+	// moves that take longer than a period.
+	ByoYomi
+	// Canadian follows the main time with periods in which a number of moves
+	// have to be made.
+	Canadian
+)
+
+// TimeControl describes how much time players get. Fields that do not apply
+// to the System are ignored.
+type TimeControl struct {
+	System      System
+	MainTime    time.Duration
+	Increment   time.Duration
+	MaxTime     time.Duration
+	Periods     int
+	PeriodTime  time.Duration
+	PeriodMoves int
+}
+
+// State is the time one player has left.
+type State struct {
+	MainTime time.Duration
+	// Periods is the number of byo-yomi periods left.
+	Periods int
+	// PeriodTime and PeriodMoves are what is left of the current Canadian
+	// overtime period.
+	PeriodTime  time.Duration
+	PeriodMoves int
+}
+
+func (tc TimeControl) Validate() error {
+	if tc.MainTime < 0 || tc.Increment < 0 || tc.MaxTime < 0 || tc.Periods < 0 || tc.PeriodTime < 0 || tc.PeriodMoves < 0 {
+		return errors.New("time control settings must not be negative")
+	}
+
+	switch tc.System {
+	case Absolute:
+		if tc.MainTime == 0 {
+			return errors.New("absolute time needs a main time")
+		}
+	case Fischer:
+		if tc.MainTime == 0 && tc.Increment == 0 {
+			return errors.New("fischer time needs a main time or an increment")
+		}
+		if tc.MaxTime > 0 && tc.MaxTime < tc.MainTime {
+			return errors.New("fischer maximum time is less than the main time")
+		}
+	case ByoYomi:
+		if tc.Periods == 0 || tc.PeriodTime == 0 {
+			return errors.New("byo-yomi needs periods and a period time")
+		}
+	case Canadian:
+		if tc.PeriodMoves == 0 || tc.PeriodTime == 0 {
+			return errors.New("canadian time needs a period time and moves per period")
+		}
+	default:
+		return errors.New("unknown time control system")
+	}
+
+	return nil
+}
+
+// Start returns the clock each player begins the game with.
+func (tc TimeControl) Start() State {
+	return State{
+		MainTime:    tc.MainTime,
+		Periods:     tc.Periods,
+		PeriodTime:  tc.PeriodTime,
+		PeriodMoves: tc.PeriodMoves,
+	}
+}
+
+// Remaining returns how long a player with clock s can think about their next
+// move before running out of time.
+func (tc TimeControl) Remaining(s State) time.Duration {
+	switch tc.System {
+	case ByoYomi:
+		return s.MainTime + time.Duration(s.Periods)*tc.PeriodTime
+	case Canadian:
+		return s.MainTime + s.PeriodTime
+	default:
+		return s.MainTime
+	}
+}
+
+// Deadline returns when a player with clock s that started running at since
+// runs out of time.
+func (tc TimeControl) Deadline(s State, since time.Time) time.Time {
+	return since.Add(tc.Remaining(s))
+}
+
+// Spend charges a move that took elapsed to the clock s. It returns the clock
+// after the move, and false if the player ran out of time while making it.
+func (tc TimeControl) Spend(s State, elapsed time.Duration) (State, bool) {
+	if elapsed > tc.Remaining(s) {
+		return s, false
+	}
+
+	switch tc.System {
+	case Fischer:
+		s.MainTime += tc.Increment - elapsed
+		if tc.MaxTime > 0 && s.MainTime > tc.MaxTime {
+			s.MainTime = tc.MaxTime
+		}
+	case ByoYomi:
+		if elapsed <= s.MainTime {
+			s.MainTime -= elapsed
+			break
+		}
+
+		// every period the move overran is used up
+		overtime := elapsed - s.MainTime
+		s.MainTime = 0
+		for overtime > tc.PeriodTime {
+			overtime -= tc.PeriodTime
+			s.Periods--
+		}
+	case Canadian:
+		if elapsed <= s.MainTime {
+			s.MainTime -= elapsed
+			break
+		}
+
+		s.PeriodTime -= elapsed - s.MainTime
+		s.MainTime = 0
+		s.PeriodMoves--
+		if s.PeriodMoves == 0 {
+			s.PeriodTime = tc.PeriodTime
+			s.PeriodMoves = tc.PeriodMoves
+		}
+	default:
+		s.MainTime -= elapsed
+	}
+
+	return s, true
+}
@@ -0,0 +1,114 @@
+package clock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestTimeControl_Spend(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tc       TimeControl
+		moves    []time.Duration
+		expected State
+		ok       bool
+	}{
+		{
+			"absolute",
+			TimeControl{System: Absolute, MainTime: time.Minute},
+			[]time.Duration{20 * time.Second, 30 * time.Second},
+			State{MainTime: 10 * time.Second},
+			true,
+		},
+		{
+			"absolute timeout",
+			TimeControl{System: Absolute, MainTime: time.Minute},
+			[]time.Duration{50 * time.Second, 11 * time.Second},
+			State{MainTime: 10 * time.Second},
+			false,
+		},
+		{
+			"fischer",
+			TimeControl{System: Fischer, MainTime: time.Minute, Increment: 10 * time.Second},
+			[]time.Duration{5 * time.Second, 30 * time.Second},
+			State{MainTime: 45 * time.Second},
+			true,
+		},
+		{
+			"fischer capped",
+			TimeControl{System: Fischer, MainTime: time.Minute, Increment: 10 * time.Second, MaxTime: time.Minute},
+			[]time.Duration{5 * time.Second},
+			State{MainTime: time.Minute},
+			true,
+		},
+		{
+			"byo-yomi keeps period",
+			TimeControl{System: ByoYomi, MainTime: time.Minute, Periods: 3, PeriodTime: 30 * time.Second},
+			[]time.Duration{50 * time.Second, 40 * time.Second, 30 * time.Second},
+			State{Periods: 3, PeriodTime: 30 * time.Second},
+			true,
+		},
+		{
+			"byo-yomi uses periods",
+			TimeControl{System: ByoYomi, MainTime: time.Minute, Periods: 3, PeriodTime: 30 * time.Second},
+			[]time.Duration{time.Minute, 61 * time.Second},
+			State{Periods: 1, PeriodTime: 30 * time.Second},
+			true,
+		},
+		{
+			"byo-yomi timeout",
+			TimeControl{System: ByoYomi, MainTime: time.Minute, Periods: 1, PeriodTime: 30 * time.Second},
+			[]time.Duration{91 * time.Second},
+			State{MainTime: time.Minute, Periods: 1, PeriodTime: 30 * time.Second},
+			false,
+		},
+		{
+			"canadian",
+			TimeControl{System: Canadian, MainTime: time.Minute, PeriodTime: 5 * time.Minute, PeriodMoves: 2},
+			[]time.Duration{2 * time.Minute, time.Minute},
+			State{PeriodTime: 5 * time.Minute, PeriodMoves: 2},
+			true,
+		},
+		{
+			"canadian in period",
+			TimeControl{System: Canadian, MainTime: time.Minute, PeriodTime: 5 * time.Minute, PeriodMoves: 3},
+			[]time.Duration{2 * time.Minute, time.Minute},
+			State{PeriodTime: 3 * time.Minute, PeriodMoves: 1},
+			true,
+		},
+		{
+			"canadian timeout",
+			TimeControl{System: Canadian, PeriodTime: 5 * time.Minute, PeriodMoves: 3},
+			[]time.Duration{4 * time.Minute, 2 * time.Minute},
+			State{PeriodTime: time.Minute, PeriodMoves: 2},
+			false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.NoError(t, testCase.tc.Validate())
+
+			s := testCase.tc.Start()
+			ok := true
+			for _, elapsed := range testCase.moves {
+				s, ok = testCase.tc.Spend(s, elapsed)
+				if !ok {
+					break
+				}
+			}
+
+			assert.Equal(t, testCase.ok, ok)
+			assert.Equal(t, testCase.expected, s)
+		})
+	}
+}
+
+func TestTimeControl_Validate(t *testing.T) {
+	assert.Error(t, TimeControl{System: Absolute}.Validate())
+	assert.Error(t, TimeControl{System: Fischer, MainTime: time.Minute, MaxTime: time.Second}.Validate())
+	assert.Error(t, TimeControl{System: ByoYomi, MainTime: time.Minute}.Validate())
+	assert.Error(t, TimeControl{System: Canadian, PeriodTime: time.Minute}.Validate())
+	assert.Error(t, TimeControl{System: System(9), MainTime: time.Minute}.Validate())
+}
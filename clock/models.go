@@ -0,0 +1,46 @@
+package clock
+
+import (
+	"github.com/tengen-io/server/models"
+	"time"
+)
+
+var systems = map[models.TimeControlSystem]System{
+	models.TimeControlSystemAbsolute: Absolute,
+	models.TimeControlSystemFischer:  Fischer,
+	models.TimeControlSystemByoYomi:  ByoYomi,
+	models.TimeControlSystemCanadian: Canadian,
+}
+
+// TimeControlFor converts a stored time control, which counts seconds.
+func TimeControlFor(tc models.TimeControl) TimeControl {
+	return TimeControl{
+		System:      systems[tc.System],
+		MainTime:    time.Duration(tc.MainTime) * time.Second,
+		Increment:   time.Duration(tc.Increment) * time.Second,
+		MaxTime:     time.Duration(tc.MaxTime) * time.Second,
+		Periods:     tc.Periods,
+		PeriodTime:  time.Duration(tc.PeriodTime) * time.Second,
+		PeriodMoves: tc.PeriodMoves,
+	}
+}
+
+func StateFor(c models.Clock) State {
+	return State{
+		MainTime:    c.MainTime,
+		Periods:     c.Periods,
+		PeriodTime:  c.PeriodTime,
+		PeriodMoves: c.PeriodMoves,
+	}
+}
+
+// ClockFor returns a stopped clock for the player at index.
+func ClockFor(index int, s State) models.Clock {
+	return models.Clock{
+		Index:       index,
+		MainTime:    s.MainTime,
+		Periods:     s.Periods,
+		PeriodTime:  s.PeriodTime,
+		PeriodMoves: s.PeriodMoves,
+	}
+}
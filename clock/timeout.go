@@ -0,0 +1,187 @@
+package clock
+
+import (
+	"github.com/tengen-io/server/db"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/pubsub"
+	"github.com/tengen-io/server/repository"
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+type games interface {
+	running() ([]models.RunningClock, error)
+	flag(models.RunningClock) error
+}
+
+type DbGames struct {
+	repo *repository.Repository
+}
+
+func (g DbGames) running() ([]models.RunningClock, error) {
+	return g.repo.GetRunningClocks()
+}
+
+// flag ends the game of c as lost on time. Several enforcers may race to flag
+// the same game, and a player may move just before it, so the game is locked
+// and the clock checked again before anything changes.
+func (g DbGames) flag(c models.RunningClock) error {
+	return g.repo.WithTx(func(r *repository.Repository) error {
+		locked, err := r.TryLockGame(c.GameId)
+		if err != nil || locked == nil {
+			return err
+		}
+
+		if locked.State != models.GameStateNegotiation && locked.State != models.GameStateInProgress {
+			return nil
+		}
+
+		clocks, err := r.GetClocks(c.GameId)
+		if err != nil {
+			return err
+		}
+
+		expired := false
+		for _, current := range clocks {
+			if current.Index == c.Clock.Index && current.RunningSince != nil {
+				deadline := TimeControlFor(c.TimeControl).Deadline(StateFor(current), *current.RunningSince)
+				expired = time.Now().After(deadline)
+			}
+		}
+
+		if !expired {
+			return nil
+		}
+
+		color := game.Black
+		if c.Clock.Index == 1 {
+			color = game.White
+		}
+
+		result := game.TimeoutResult(color).String()
+		err = r.FinishGame(c.GameId, result)
+		if err != nil {
+			return err
+		}
+
+		err = r.SaveClock(c.GameId, models.Clock{Index: c.Clock.Index})
+		if err != nil {
+			return err
+		}
+
+		log.Printf("game %s: %s", c.GameId, result)
+		return r.Publish(pubsub.TopicCategoryGames, pubsub.Event{
+			Subject: c.GameId,
+			Event:   models.GameEventTypeStateChange.String(),
+			Payload: map[string]interface{}{
+				"state":      models.GameStateFinished.String(),
+				"gameResult": result,
+			},
+		})
+	})
+}
+
+type enforcer struct {
+	games        games
+	tickInterval time.Duration
+}
+
+func newEnforcer(games games, tick time.Duration) *enforcer {
+	return &enforcer{
+		games:        games,
+		tickInterval: tick,
+	}
+}
+
+func (e *enforcer) run() {
+	for true {
+		now := time.Now()
+		expired, next, err := e.tick(now)
+		if err != nil {
+			log.Printf("error checking clocks: %s", err)
+		}
+
+		for _, c := range expired {
+			err = e.games.flag(c)
+			if err != nil {
+				log.Printf("error flagging game %s: %s", c.GameId, err)
+			}
+		}
+
+		// wake up early for a deadline that falls before the next tick
+		sleep := e.tickInterval
+		if !next.IsZero() && next.Sub(now) < sleep {
+			sleep = next.Sub(now) + time.Millisecond
+		}
+
+		time.Sleep(sleep)
+	}
+}
+
+// tick returns the running clocks that had run out by now, and the earliest
+// deadline of the others.
+func (e *enforcer) tick(now time.Time) ([]models.RunningClock, time.Time, error) {
+	clocks, err := e.games.running()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var next time.Time
+	expired := make([]models.RunningClock, 0)
+	for _, c := range clocks {
+		deadline := TimeControlFor(c.TimeControl).Deadline(StateFor(c.Clock), *c.Clock.RunningSince)
+		if now.After(deadline) {
+			expired = append(expired, c)
+			continue
+		}
+
+		if next.IsZero() || deadline.Before(next) {
+			next = deadline
+		}
+	}
+
+	return expired, next, nil
+}
+
+// Start runs the enforcer that flags players who run out of time. Any number
+// of enforcers can run side by side.
+func Start() {
+	repo := makeRepo()
+	games := DbGames{
+		repo,
+	}
+
+	log.Printf("starting clock enforcer")
+	tickTimeMs, err := strconv.Atoi(os.Getenv("TENGEN_CLOCK_TICK_TIME_MS"))
+	if err != nil {
+		log.Fatalf("Could not parse TENGEN_CLOCK_TICK_TIME_MS")
+	}
+
+	enforcer := newEnforcer(games, time.Duration(tickTimeMs)*time.Millisecond)
+	enforcer.run()
+}
+
+func makeRepo() *repository.Repository {
+	port, err := strconv.Atoi(os.Getenv("TENGEN_DB_PORT"))
+	if err != nil {
+		log.Fatal("Could not parse TENGEN_DB_PORT")
+	}
+
+	config := db.PostgresDBConfig{
+		Host:     os.Getenv("TENGEN_DB_HOST"),
+		Port:     port,
+		User:     os.Getenv("TENGEN_DB_USER"),
+		Database: os.Getenv("TENGEN_DB_DATABASE"),
+		Password: os.Getenv("TENGEN_DB_PASSWORD"),
+	}
+
+	db, err := db.NewPostgresDb(config)
+	if err != nil {
+		log.Fatal("Unable to connect to DB.", err)
+	}
+
+	return repository.NewRepository(db, pubsub.NewDbPubSub(config.Url()))
+}
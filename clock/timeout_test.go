@@ -0,0 +1,58 @@
+package clock
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tengen-io/server/models"
+	"testing"
+	"time"
+)
+
+type inMemoryGames struct {
+	clocks  []models.RunningClock
+	flagged []models.RunningClock
+}
+
+func (g *inMemoryGames) running() ([]models.RunningClock, error) {
+	return g.clocks, nil
+}
+
+func (g *inMemoryGames) flag(c models.RunningClock) error {
+	g.flagged = append(g.flagged, c)
+	return nil
+}
+
+func Test_tick(t *testing.T) {
+	now := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	absolute := models.TimeControl{System: models.TimeControlSystemAbsolute, MainTime: 60}
+	byoYomi := models.TimeControl{System: models.TimeControlSystemByoYomi, MainTime: 60, Periods: 3, PeriodTime: 30}
+
+	games := inMemoryGames{
+		clocks: []models.RunningClock{
+			running("1", absolute, time.Minute, 0, now.Add(-61*time.Second)),
+			running("2", absolute, time.Minute, 0, now.Add(-30*time.Second)),
+			running("3", byoYomi, 0, 3, now.Add(-time.Minute)),
+			running("4", byoYomi, 0, 1, now.Add(-31*time.Second)),
+		},
+	}
+
+	enforcer := newEnforcer(&games, time.Second)
+	expired, next, err := enforcer.tick(now)
+	assert.NoError(t, err)
+	assert.Len(t, expired, 2)
+	assert.Equal(t, "1", expired[0].GameId)
+	assert.Equal(t, "4", expired[1].GameId)
+	assert.Equal(t, now.Add(30*time.Second), next)
+}
+
+func running(gameId string, tc models.TimeControl, mainTime time.Duration, periods int, since time.Time) models.RunningClock {
+	return models.RunningClock{
+		GameId: gameId,
+		Clock: models.Clock{
+			MainTime:     mainTime,
+			Periods:      periods,
+			PeriodTime:   time.Duration(tc.PeriodTime) * time.Second,
+			RunningSince: &since,
+		},
+		TimeControl: tc,
+	}
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"github.com/tengen-io/server/gql"
+	"log"
+)
+
+func init() {
+	botsCmd.AddCommand(createBotCmd)
+	rootCmd.AddCommand(botsCmd)
+}
+
+var botsCmd = &cobra.Command{
+	Use:   "bots",
+	Short: "runs the tengen.io bot worker, which plays for bot accounts whenever it is their turn",
+	Run: func(cmd *cobra.Command, args []string) {
+		runBots()
+	},
+}
+
+var createBotCmd = &cobra.Command{
+	Use:   "create NAME ENGINE [ARGS...]",
+	Short: "creates a bot account played by a GTP engine, e.g. create GnuGo gnugo --mode gtp",
+	Args:  cobra.MinimumNArgs(2),
+	// the engine's own flags are passed through untouched
+	DisableFlagParsing: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		createBot(args[0], args[1:])
+	},
+}
+
+func runBots() {
+	gql.StartBots()
+}
+
+func createBot(name string, command []string) {
+	bot, err := gql.CreateBot(name, command)
+	if err != nil {
+		log.Fatal("Could not create bot. ", err)
+	}
+
+	fmt.Printf("created bot %s with user id %s\n", bot.User.Name, bot.User.Id)
+}
@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tengen-io/server/clock"
+)
+
+func init() {
+	rootCmd.AddCommand(clockCmd)
+}
+
+var clockCmd = &cobra.Command{
+	Use:   "clock",
+	Short: "runs the tengen.io clock enforcer, which flags players who run out of time",
+	Run: func(cmd *cobra.Command, args []string) {
+		runClock()
+	},
+}
+
+func runClock() {
+	clock.Start()
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/gtp"
+	"log"
+	"os"
+	"strings"
+)
+
+var gtpRules string
+
+func init() {
+	gtpCmd.Flags().StringVar(&gtpRules, "rules", game.ChineseRules.Name(), "ruleset to play and score under, e.g. JAPANESE or TROMP_TAYLOR")
+	rootCmd.AddCommand(gtpCmd)
+}
+
+var gtpCmd = &cobra.Command{
+	Use:   "gtp",
+	Short: "speaks GTP on stdin and stdout with the tengen.io rules engine, for use with tools like gogui-twogtp",
+	Run: func(cmd *cobra.Command, args []string) {
+		runGtp()
+	},
+}
+
+func runGtp() {
+	rules, err := game.RulesetForName(strings.ToUpper(gtpRules))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = gtp.NewServer(rules).Serve(os.Stdin, os.Stdout)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
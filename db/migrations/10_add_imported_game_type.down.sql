@@ -0,0 +1,8 @@
+DELETE FROM dead_stones WHERE game_id IN (SELECT id FROM games WHERE type = 'IMPORTED');
+DELETE FROM moves WHERE game_id IN (SELECT id FROM games WHERE type = 'IMPORTED');
+DELETE FROM game_user WHERE game_id IN (SELECT id FROM games WHERE type = 'IMPORTED');
+DELETE FROM games WHERE type = 'IMPORTED';
+ALTER TYPE game_type RENAME TO game_type_old;
+CREATE TYPE game_type AS ENUM ('STANDARD');
+ALTER TABLE games ALTER COLUMN type TYPE game_type USING type::text::game_type;
+DROP TYPE game_type_old;
@@ -0,0 +1 @@
+ALTER TYPE game_type ADD VALUE 'IMPORTED';
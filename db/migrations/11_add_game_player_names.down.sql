@@ -0,0 +1,2 @@
+ALTER TABLE games DROP COLUMN IF EXISTS white_name;
+ALTER TABLE games DROP COLUMN IF EXISTS black_name;
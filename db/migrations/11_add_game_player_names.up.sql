@@ -0,0 +1,2 @@
+ALTER TABLE games ADD COLUMN black_name text;
+ALTER TABLE games ADD COLUMN white_name text;
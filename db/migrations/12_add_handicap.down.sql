@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS handicap_stones;
+ALTER TABLE games DROP COLUMN IF EXISTS handicap_placement;
+ALTER TABLE games DROP COLUMN IF EXISTS handicap;
+DROP TYPE IF EXISTS handicap_placement;
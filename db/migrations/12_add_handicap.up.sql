@@ -0,0 +1,12 @@
+CREATE TYPE handicap_placement AS ENUM ('FIXED', 'FREE');
+
+ALTER TABLE games ADD COLUMN handicap integer NOT NULL DEFAULT 0;
+ALTER TABLE games ADD COLUMN handicap_placement handicap_placement NOT NULL DEFAULT 'FIXED';
+
+CREATE TABLE handicap_stones (
+    game_id integer REFERENCES games(id) NOT NULL,
+    x integer NOT NULL,
+    y integer NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (game_id, x, y)
+);
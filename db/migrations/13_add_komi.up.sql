@@ -0,0 +1,5 @@
+ALTER TABLE games ADD COLUMN komi double precision NOT NULL DEFAULT 7.5;
+
+UPDATE games SET komi = 6.5 WHERE ruleset = 'JAPANESE';
+UPDATE games SET komi = 7 WHERE ruleset = 'NEW_ZEALAND';
+UPDATE games SET komi = 0.5 WHERE handicap > 0;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS clocks;
+DROP TABLE IF EXISTS time_controls;
+DROP TYPE IF EXISTS time_control_system;
@@ -0,0 +1,25 @@
+CREATE TYPE time_control_system AS ENUM ('ABSOLUTE', 'FISCHER', 'BYO_YOMI', 'CANADIAN');
+
+CREATE TABLE time_controls (
+    game_id integer REFERENCES games(id) PRIMARY KEY,
+    system time_control_system NOT NULL,
+    main_time integer NOT NULL,
+    increment integer NOT NULL,
+    max_time integer NOT NULL,
+    periods integer NOT NULL,
+    period_time integer NOT NULL,
+    period_moves integer NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+CREATE TABLE clocks (
+    game_id integer REFERENCES games(id) NOT NULL,
+    index integer NOT NULL,
+    main_time bigint NOT NULL,
+    periods integer NOT NULL,
+    period_time bigint NOT NULL,
+    period_moves integer NOT NULL,
+    running_since timestamp without time zone,
+    updated_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (game_id, index)
+);
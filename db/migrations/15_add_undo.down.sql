@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS clock_history;
+ALTER TABLE games DROP COLUMN IF EXISTS undo_request;
+ALTER TABLE games DROP COLUMN IF EXISTS undo_allowed;
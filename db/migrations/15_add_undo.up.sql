@@ -0,0 +1,16 @@
+-- games from before undo existed keep going without it, while new games
+-- allow it unless they say otherwise, as challenges do
+ALTER TABLE games ADD COLUMN undo_allowed boolean NOT NULL DEFAULT false;
+ALTER TABLE games ALTER COLUMN undo_allowed SET DEFAULT true;
+ALTER TABLE games ADD COLUMN undo_request integer;
+
+CREATE TABLE clock_history (
+    game_id integer REFERENCES games(id) NOT NULL,
+    number integer NOT NULL,
+    index integer NOT NULL,
+    main_time bigint NOT NULL,
+    periods integer NOT NULL,
+    period_time bigint NOT NULL,
+    period_moves integer NOT NULL,
+    PRIMARY KEY (game_id, number)
+);
@@ -0,0 +1,7 @@
+CREATE TABLE game_snapshots (
+    game_id integer REFERENCES games(id) NOT NULL,
+    number integer NOT NULL,
+    data bytea NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (game_id, number)
+);
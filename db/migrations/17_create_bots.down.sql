@@ -0,0 +1,15 @@
+-- Bot accounts cannot be kept without an identity, and removing them would
+-- take the games people played against them with them, so refuse instead.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM game_user gu JOIN users u ON u.id = gu.user_id WHERE u.identity_id IS NULL) THEN
+        RAISE EXCEPTION 'bots have played games; remove those games before rolling back';
+    END IF;
+END
+$$;
+
+DELETE FROM matchmake_requests WHERE user_id IN (SELECT id FROM users WHERE identity_id IS NULL);
+DROP TABLE IF EXISTS bots;
+DROP TYPE IF EXISTS bot_type;
+DELETE FROM users WHERE identity_id IS NULL;
+ALTER TABLE users ALTER COLUMN identity_id SET NOT NULL;
@@ -0,0 +1,10 @@
+ALTER TABLE users ALTER COLUMN identity_id DROP NOT NULL;
+
+CREATE TYPE bot_type AS ENUM ('GTP');
+
+CREATE TABLE bots (
+    user_id integer REFERENCES users(id) PRIMARY KEY,
+    type bot_type NOT NULL,
+    command text NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
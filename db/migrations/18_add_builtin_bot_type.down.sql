@@ -0,0 +1,5 @@
+DELETE FROM bots WHERE type = 'BUILTIN';
+ALTER TYPE bot_type RENAME TO bot_type_old;
+CREATE TYPE bot_type AS ENUM ('GTP');
+ALTER TABLE bots ALTER COLUMN type TYPE bot_type USING type::text::bot_type;
+DROP TYPE bot_type_old;
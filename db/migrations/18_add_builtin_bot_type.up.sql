@@ -0,0 +1 @@
+ALTER TYPE bot_type ADD VALUE 'BUILTIN';
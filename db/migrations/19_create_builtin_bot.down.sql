@@ -0,0 +1,3 @@
+DELETE FROM bots WHERE type = 'BUILTIN' AND user_id IN (SELECT id FROM users WHERE name = 'tengen bot' AND identity_id IS NULL);
+-- the account stays while games refer to it
+DELETE FROM users WHERE name = 'tengen bot' AND identity_id IS NULL AND id NOT IN (SELECT user_id FROM game_user) AND id NOT IN (SELECT user_id FROM moves);
@@ -0,0 +1,4 @@
+-- A person who already took the name keeps it, and the deployment goes
+-- without the built-in bot until one is created under another name.
+INSERT INTO users (name, created_at, updated_at) VALUES ('tengen bot', now() at time zone 'utc', now() at time zone 'utc') ON CONFLICT (name) DO NOTHING;
+INSERT INTO bots (user_id, type, command, created_at) SELECT id, 'BUILTIN', 'safe', created_at FROM users WHERE name = 'tengen bot' AND identity_id IS NULL ON CONFLICT (user_id) DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE game_user ADD COLUMN index integer NOT NULL DEFAULT 0;
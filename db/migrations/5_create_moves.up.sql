@@ -0,0 +1,14 @@
+CREATE TYPE move_type AS ENUM ('STONE', 'PASS');
+
+CREATE TABLE moves (
+    id bigserial PRIMARY KEY,
+    game_id integer REFERENCES games(id) NOT NULL,
+    user_id integer REFERENCES users(id) NOT NULL,
+    number integer NOT NULL,
+    type move_type NOT NULL,
+    x integer,
+    y integer,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL,
+    UNIQUE (game_id, number)
+);
@@ -0,0 +1,5 @@
+UPDATE games SET state = 'IN_PROGRESS' WHERE state = 'SCORING';
+ALTER TYPE game_state RENAME TO game_state_old;
+CREATE TYPE game_state AS ENUM ('NEGOTIATION', 'IN_PROGRESS', 'FINISHED');
+ALTER TABLE games ALTER COLUMN state TYPE game_state USING state::text::game_state;
+DROP TYPE game_state_old;
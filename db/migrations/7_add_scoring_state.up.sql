@@ -0,0 +1 @@
+ALTER TYPE game_state ADD VALUE 'SCORING' AFTER 'IN_PROGRESS';
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS dead_stones;
+ALTER TABLE game_user DROP COLUMN IF EXISTS score_accepted;
@@ -0,0 +1,9 @@
+ALTER TABLE game_user ADD COLUMN score_accepted boolean NOT NULL DEFAULT false;
+
+CREATE TABLE dead_stones (
+    game_id integer REFERENCES games(id) NOT NULL,
+    x integer NOT NULL,
+    y integer NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (game_id, x, y)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE matchmake_requests DROP COLUMN IF EXISTS ruleset;
+ALTER TABLE games DROP COLUMN IF EXISTS ruleset;
+DROP TYPE IF EXISTS ruleset;
@@ -0,0 +1,4 @@
+CREATE TYPE ruleset AS ENUM ('CHINESE', 'JAPANESE', 'AGA', 'NEW_ZEALAND', 'TROMP_TAYLOR');
+
+ALTER TABLE games ADD COLUMN ruleset ruleset NOT NULL DEFAULT 'CHINESE';
+ALTER TABLE matchmake_requests ADD COLUMN ruleset ruleset NOT NULL DEFAULT 'CHINESE';
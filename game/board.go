@@ -11,6 +11,13 @@ type nodestring []int
 type Board struct {
 	size  int
 	board []node
+	// chains maps every stone to the chain it belongs to, and is nil for
+	// empty points. SetNode and RemoveString keep it up to date.
+	chains []*chain
+	// hash is the Zobrist hash of the stones on the board, kept up to date by
+	// SetNode and RemoveString.
+	hash    uint64
+	zobrist *zobristKeys
 }
 
 const (
@@ -23,11 +30,17 @@ const (
 func NewBoard(size int) *Board {
 	board := make([]node, size*size)
 	return &Board{
-		size:  size,
-		board: board,
+		size:    size,
+		board:   board,
+		chains:  make([]*chain, size*size),
+		zobrist: zobristFor(size),
 	}
 }
 
+func (b *Board) Size() int {
+	return b.size
+}
+
 func (b *Board) GetNode(x int, y int) node {
 	if x < 0 || x >= b.size {
 		return edge
@@ -41,7 +54,26 @@ func (b *Board) GetNode(x int, y int) node {
 }
 
 func (b *Board) SetNode(x int, y int, value node) {
-	b.board[b.idx(x, y)] = value
+	idx := b.idx(x, y)
+	old := b.board[idx]
+	if old == value {
+		return
+	}
+
+	b.hash ^= b.zobrist.key(idx, old) ^ b.zobrist.key(idx, value)
+	if old != empty {
+		b.liftStone(idx)
+	}
+
+	if value != empty {
+		b.placeStone(idx, value)
+	}
+}
+
+// Hash returns the Zobrist hash of the stones on the board. Boards of the same
+// size with the same stones have the same hash.
+func (b *Board) Hash() uint64 {
+	return b.hash
 }
 
 func (b *Board) GetString(x int, y int) (nodestring, error) {
@@ -63,143 +95,78 @@ func (b *Board) GetNeighbors(x int, y int) (node, node, node, node) {
 }
 
 func (b *Board) IsInString(haystack nodestring, x int, y int) bool {
-	i := sort.SearchInts(haystack, b.idx(x, y))
-	return i < len(haystack)
+	return haystack.contains(b.idx(x, y))
 }
 
 func (b *Board) GetStringAndNeighbors(x int, y int) (nodestring, []nodestring) {
-	origin := b.GetNode(x, y)
+	idx := b.idx(x, y)
 	var string nodestring = nil
-	if origin == white || origin == black {
-		string = b.findString(b.idx(x, y))
+	if c := b.chains[idx]; c != nil {
+		string = b.findString(idx)
 	}
 	neighbors := make([]nodestring, 0)
 
-	// Technically we can dedupe strings more efficiently by unrolling this loop but
-	// we can optimize this later if necessary
-	isDupe := func(idx int) bool {
-		if string != nil && sort.SearchInts(string, idx) < len(string) {
-			return true
-		}
-
-		for _, str := range neighbors {
-			i := sort.SearchInts(str, idx)
-			if i < len(str) && str[i] == idx {
-				return true
-			}
+	seen := make([]*chain, 0, 4)
+	adj, n := b.adjacent(idx)
+	for _, a := range adj[:n] {
+		c := b.chains[a]
+		if c == nil || c == b.chains[idx] || containsChain(seen, c) {
+			continue
 		}
-		return false
-	}
 
-	north := b.GetNode(x, y+1)
-	if north == white || north == black {
-		neighbors = append(neighbors, b.findString(b.idx(x, y+1)))
-	}
-
-	south := b.GetNode(x, y-1)
-	if south == white || south == black && !isDupe(b.idx(x, y-1)) {
-		neighbors = append(neighbors, b.findString(b.idx(x, y-1)))
-	}
-
-	east := b.GetNode(x+1, y)
-	if east == white || east == black && !isDupe(b.idx(x+1, y)) {
-		neighbors = append(neighbors, b.findString(b.idx(x+1, y)))
-	}
-
-	west := b.GetNode(x-1, y)
-	if west == white || west == black && !isDupe(b.idx(x-1, y)) {
-		neighbors = append(neighbors, b.findString(b.idx(x-1, y)))
+		seen = append(seen, c)
+		neighbors = append(neighbors, b.findString(a))
 	}
 
 	return string, neighbors
 }
 
+// CountLiberties returns the number of liberties of the string.
 func (b *Board) CountLiberties(string nodestring) int {
-	rv := 0
-	seen := make(map[int]bool)
-	for _, idx := range string {
-		x, y := b.coord(idx)
-		north := b.GetNode(x, y+1)
-		south := b.GetNode(x, y-1)
-		east := b.GetNode(x+1, y)
-		west := b.GetNode(x-1, y)
-
-		if north == empty && !seen[b.idx(x, y+1)] {
-			rv += 1
-			seen[b.idx(x, y+1)] = true
-		}
-
-		if south == empty && !seen[b.idx(x, y-1)] {
-			rv += 1
-			seen[b.idx(x, y-1)] = true
-		}
+	if len(string) == 0 || b.chains[string[0]] == nil {
+		return 0
+	}
 
-		if east == empty && !seen[b.idx(x+1, y)] {
-			rv += 1
-			seen[b.idx(x+1, y)] = true
-		}
+	return b.chains[string[0]].libs.count()
+}
 
-		if west == empty && !seen[b.idx(x-1, y)] {
-			rv += 1
-			seen[b.idx(x-1, y)] = true
-		}
+// liberties returns the distinct empty points adjacent to string.
+func (b *Board) liberties(string nodestring) []int {
+	if len(string) == 0 || b.chains[string[0]] == nil {
+		return []int{}
 	}
 
-	return rv
+	return b.chains[string[0]].libs.points()
 }
 
 func (b *Board) StringColor(string nodestring) node {
 	return b.board[string[0]]
 }
 
+// findString returns the sorted stones of the chain through idx.
 func (b *Board) findString(idx int) nodestring {
-	rv := make([]int, 0)
-	stack := make([]int, 0)
-	seen := make(map[int]bool)
-	stack = append(stack, idx)
-	origColor := b.board[idx]
-
-	for len(stack) > 0 {
-		i := stack[0]
-		stack = stack[1:]
-		seen[i] = true
-		color := b.board[idx]
-		if color == origColor {
-			rv = append(rv, i)
-		}
-
-		northIdx := i + b.size
-		southIdx := i - b.size
-		eastIdx := i + 1
-		westIdx := i - 1
-
-		if northIdx < b.size*b.size && !seen[northIdx] && b.board[northIdx] == origColor {
-			stack = append(stack, northIdx)
-		}
-
-		if southIdx > 0 && !seen[southIdx] && b.board[southIdx] == origColor {
-			stack = append(stack, southIdx)
-		}
-
-		if eastIdx%b.size > idx%b.size && !seen[eastIdx] && b.board[eastIdx] == origColor {
-			stack = append(stack, eastIdx)
-		}
-
-		if westIdx >= 0 && westIdx%b.size < idx%b.size && !seen[westIdx] && b.board[westIdx] == origColor {
-			stack = append(stack, westIdx)
-		}
-	}
-
+	rv := append(nodestring(nil), b.chains[idx].stones...)
 	sort.Ints(rv)
 	return rv
 }
 
+// RemoveString takes the strings containing the given stones off the board
+// and returns how many stones were removed.
 func (b *Board) RemoveString(string nodestring) int {
+	rv := 0
 	for _, idx := range string {
-		b.board[idx] = empty
+		if c := b.chains[idx]; c != nil {
+			rv += b.removeChain(c)
+		}
 	}
 
-	return len(string)
+	return rv
+}
+
+// contains reports whether idx is part of the string. Strings are kept sorted.
+func (s nodestring) contains(idx int) bool {
+	i := sort.SearchInts(s, idx)
+	return i < len(s) && s[i] == idx
 }
 
 func (b *Board) idx(x int, y int) int {
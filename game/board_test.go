@@ -51,6 +51,25 @@ func TestBoard_GetNeighboringStrings(t *testing.T) {
 	assertStringContains(t, strings, []int{8, 13, 18})
 }
 
+func TestBoard_GetString(t *testing.T) {
+	board := NewBoard(5)
+	// a U shape whose arms are only connected through the bottom row
+	board.SetNode(0, 2, black)
+	board.SetNode(0, 1, black)
+	board.SetNode(0, 0, black)
+	board.SetNode(1, 0, black)
+	board.SetNode(2, 0, black)
+	board.SetNode(2, 1, black)
+	board.SetNode(2, 2, black)
+	board.SetNode(4, 0, black)
+
+	string, err := board.GetString(2, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, nodestring{0, 1, 2, 5, 7, 10, 12}, string)
+	assert.True(t, board.IsInString(string, 0, 2))
+	assert.False(t, board.IsInString(string, 4, 0))
+}
+
 func TestBoard_CountLiberties(t *testing.T) {
 	board := NewBoard(5)
 	board.SetNode(0, 4, black)
@@ -93,6 +112,110 @@ func TestBoard_RemoveString(t *testing.T) {
 	assert.Equal(t, black, board.GetNode(3, 1))
 }
 
+func TestBoard_Hash(t *testing.T) {
+	board := NewBoard(5)
+	assert.Equal(t, uint64(0), board.Hash())
+
+	board.SetNode(1, 1, black)
+	board.SetNode(2, 1, black)
+	board.SetNode(3, 3, white)
+	hash := board.Hash()
+	assert.NotEqual(t, uint64(0), hash)
+
+	other := NewBoard(5)
+	other.SetNode(3, 3, white)
+	other.SetNode(2, 1, black)
+	other.SetNode(1, 1, black)
+	assert.Equal(t, hash, other.Hash())
+
+	string, err := board.GetString(1, 1)
+	assert.Nil(t, err)
+	board.RemoveString(string)
+	board.SetNode(3, 3, empty)
+	assert.Equal(t, uint64(0), board.Hash())
+
+	assert.NotEqual(t, NewBoard(9).zobrist, board.zobrist)
+}
+
+func TestBoard_SetNode_SplitsString(t *testing.T) {
+	board := NewBoard(5)
+	board.SetNode(0, 2, black)
+	board.SetNode(1, 2, black)
+	board.SetNode(2, 2, black)
+	board.SetNode(2, 2, empty)
+
+	string, err := board.GetString(0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, nodestring{10, 11}, string)
+	assert.Equal(t, 5, board.CountLiberties(string))
+
+	board.SetNode(1, 2, white)
+	_, strings := board.GetStringAndNeighbors(1, 2)
+	assert.Len(t, strings, 1)
+	assert.Equal(t, 2, board.CountLiberties(strings[0]))
+}
+
+// TestBoard_Chains replays a long random game and checks after every move
+// that the chains kept by the board match the ones found from scratch.
+func TestBoard_Chains(t *testing.T) {
+	game := NewGame(9)
+	for i, move := range randomGame(9, 200, 2) {
+		assert.Nil(t, play(game, move.X, move.Y))
+
+		fresh := NewBoard(9)
+		for idx, n := range game.board.board {
+			if n != empty {
+				fresh.board[idx] = n
+			}
+		}
+
+		for idx, n := range game.board.board {
+			if n == empty {
+				assert.Nil(t, game.board.chains[idx], "move %d", i)
+				continue
+			}
+
+			if fresh.chains[idx] == nil {
+				fresh.buildChain(idx)
+			}
+
+			string := game.board.findString(idx)
+			assert.Equal(t, fresh.findString(idx), string, "move %d", i)
+			assert.Equal(t, fresh.liberties(string), game.board.liberties(string), "move %d", i)
+		}
+	}
+}
+
+func BenchmarkBoard_CountLiberties(b *testing.B) {
+	board := NewBoard(19)
+	for x := 0; x < 19; x++ {
+		board.SetNode(x, 9, black)
+	}
+
+	string, err := board.GetString(0, 9)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		board.CountLiberties(string)
+	}
+}
+
+func BenchmarkBoard_GetStringAndNeighbors(b *testing.B) {
+	board := NewBoard(19)
+	for x := 0; x < 19; x++ {
+		board.SetNode(x, 8, black)
+		board.SetNode(x, 10, white)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		board.GetStringAndNeighbors(9, 9)
+	}
+}
+
 // for debugging
 func printBoard(board *Board) {
 	for y := board.size - 1; y >= 0; y-- {
@@ -0,0 +1,166 @@
+package game
+
+import "math/rand"
+
+// BotLevel is how hard a Bot tries. Each level plays like the one before it
+// when it has nothing better to do.
+type BotLevel int
+
+const (
+	// BotLevelRandom plays random legal moves that do not fill its own eyes.
+	BotLevelRandom BotLevel = iota
+	// BotLevelCapture takes stones whenever it can.
+	BotLevelCapture
+	// BotLevelSafe also saves its strings from atari and avoids putting its
+	// own strings in atari.
+	BotLevelSafe
+)
+
+// Bot is a simple computer opponent for practice games.
+type Bot struct {
+	level BotLevel
+	rand  *rand.Rand
+}
+
+func NewBot(level BotLevel, seed int64) *Bot {
+	return &Bot{
+		level: level,
+		rand:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// GenMove chooses a move for the player to move in g. It returns false if the
+// bot passes, which it does once nothing sensible is left to play.
+func (b *Bot) GenMove(g *Game) (Point, bool) {
+	own := toNode(g.currentColor)
+	candidates := make([]Point, 0)
+	var captures, escapes []Point
+	bestCapture := 0
+
+	for y := 0; y < g.board.size; y++ {
+		for x := 0; x < g.board.size; x++ {
+			// suicide is never worth it, even where the rules allow it
+			o, err := g.check(x, y)
+			if err != nil || o.suicide || g.board.isEye(x, y, own) {
+				continue
+			}
+
+			p := Point{x, y}
+			if b.level == BotLevelRandom {
+				candidates = append(candidates, p)
+				continue
+			}
+
+			if captured := o.capturedStones(); captured > 0 {
+				if captured > bestCapture {
+					captures, bestCapture = nil, captured
+				}
+				if captured == bestCapture {
+					captures = append(captures, p)
+				}
+				continue
+			}
+
+			if b.level < BotLevelSafe {
+				candidates = append(candidates, p)
+				continue
+			}
+
+			if g.libertiesAfter(o) < 2 {
+				continue
+			}
+
+			if g.savesAtari(x, y) {
+				escapes = append(escapes, p)
+			}
+
+			candidates = append(candidates, p)
+		}
+	}
+
+	if len(captures) > 0 {
+		return b.pick(captures), true
+	}
+
+	if len(escapes) > 0 {
+		return b.pick(escapes), true
+	}
+
+	// After the opponent passes the stronger levels only answer with moves
+	// that change the outcome of a fight, so the game can end.
+	if b.level >= BotLevelCapture && g.passes > 0 {
+		return Point{}, false
+	}
+
+	if len(candidates) == 0 {
+		return Point{}, false
+	}
+
+	return b.pick(candidates), true
+}
+
+func (b *Bot) pick(points []Point) Point {
+	return points[b.rand.Intn(len(points))]
+}
+
+// isEye reports whether the empty point x, y is an eye of color: every
+// neighbour is a stone of color, and the opponent holds at most one of the
+// diagonal points, or none on the edge of the board.
+func (b *Board) isEye(x int, y int, color node) bool {
+	for _, n := range [][2]int{{x, y + 1}, {x, y - 1}, {x + 1, y}, {x - 1, y}} {
+		neighbor := b.GetNode(n[0], n[1])
+		if neighbor != color && neighbor != edge {
+			return false
+		}
+	}
+
+	opponent, edges := 0, 0
+	for _, d := range [][2]int{{x + 1, y + 1}, {x + 1, y - 1}, {x - 1, y + 1}, {x - 1, y - 1}} {
+		switch b.GetNode(d[0], d[1]) {
+		case edge:
+			edges = 1
+		case empty, color:
+		default:
+			opponent += 1
+		}
+	}
+
+	return opponent+edges < 2
+}
+
+// capturedStones returns how many of the opponent's stones the move takes.
+func (o outcome) capturedStones() int {
+	rv := 0
+	for _, string := range o.toRemove {
+		rv += len(string.stones)
+	}
+
+	return rv
+}
+
+// libertiesAfter returns the number of liberties the string of the stone
+// played with outcome o would have.
+func (g *Game) libertiesAfter(o outcome) int {
+	tmp := g.board.clone()
+	for _, string := range o.toRemove {
+		tmp.removeChain(tmp.chains[string.stones[0]])
+	}
+
+	x, y := tmp.coord(o.idx)
+	tmp.SetNode(x, y, toNode(g.currentColor))
+	return tmp.chains[o.idx].libs.count()
+}
+
+// savesAtari reports whether playing at x, y extends a string of the player
+// to move that is in atari.
+func (g *Game) savesAtari(x int, y int) bool {
+	adj, n := g.board.adjacent(g.board.idx(x, y))
+	for _, a := range adj[:n] {
+		string := g.board.chains[a]
+		if string != nil && string.color == toNode(g.currentColor) && string.libs.count() == 1 {
+			return true
+		}
+	}
+
+	return false
+}
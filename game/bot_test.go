@@ -0,0 +1,95 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBot_GenMove_Capture(t *testing.T) {
+	game := NewGame(5)
+	for _, move := range []Point{{1, 0}, {0, 0}, {4, 4}, {1, 1}} {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+
+	// black to move, white's corner stone has one liberty left at 0, 1
+	p, ok := NewBot(BotLevelCapture, 1).GenMove(game)
+	assert.True(t, ok)
+	assert.Equal(t, Point{0, 1}, p)
+}
+
+func TestBot_GenMove_Random(t *testing.T) {
+	game := NewGame(5)
+	for _, move := range []Point{{1, 0}, {0, 0}, {4, 4}, {1, 1}} {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+
+	// the random level takes the capture at 0, 1 no more often than any other
+	// move
+	legal := len(game.LegalMoves())
+	seen := make(map[Point]bool)
+	for seed := int64(0); seed < 200; seed++ {
+		p, ok := NewBot(BotLevelRandom, seed).GenMove(game)
+		assert.True(t, ok)
+		seen[p] = true
+	}
+
+	assert.True(t, seen[Point{0, 1}])
+	assert.Len(t, seen, legal)
+}
+
+func TestBot_GenMove_Escape(t *testing.T) {
+	game := NewGame(5)
+	for _, move := range []*Point{{2, 2}, {1, 2}, nil, {3, 2}, nil, {2, 1}} {
+		if move == nil {
+			game.Pass()
+		} else {
+			assert.Nil(t, play(game, move.X, move.Y))
+		}
+	}
+
+	// black's stone is in atari and can only run to 2, 3
+	for seed := int64(0); seed < 10; seed++ {
+		p, ok := NewBot(BotLevelSafe, seed).GenMove(game)
+		assert.True(t, ok)
+		assert.Equal(t, Point{2, 3}, p)
+	}
+}
+
+func TestBot_GenMove_Eyes(t *testing.T) {
+	game := NewGame(3)
+	for _, move := range []*Point{{1, 0}, nil, {0, 1}, nil, {1, 1}, nil, {2, 1}, nil, {1, 2}, nil} {
+		if move == nil {
+			game.Pass()
+		} else {
+			assert.Nil(t, play(game, move.X, move.Y))
+		}
+	}
+
+	// every empty point is one of black's eyes
+	_, ok := NewBot(BotLevelRandom, 1).GenMove(game)
+	assert.False(t, ok)
+
+	assert.True(t, game.board.isEye(0, 0, black))
+	assert.False(t, game.board.isEye(0, 0, white))
+}
+
+func TestBot_SelfPlay(t *testing.T) {
+	for _, level := range []BotLevel{BotLevelRandom, BotLevelCapture, BotLevelSafe} {
+		game := NewGame(9)
+		bots := []*Bot{NewBot(level, 1), NewBot(level, 2)}
+
+		for i := 0; game.ConsecutivePasses() < 2; i++ {
+			if i > 1000 {
+				t.Fatalf("level %d did not finish a game", level)
+			}
+
+			p, ok := bots[i%2].GenMove(game)
+			if !ok {
+				game.Pass()
+				continue
+			}
+
+			assert.Nil(t, play(game, p.X, p.Y))
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package game
+
+import "math/bits"
+
+// chain is a string of connected stones of one color together with its
+// liberties. The board keeps chains up to date as stones are placed and
+// removed, so looking up a string or counting its liberties does not need
+// to search the board.
+type chain struct {
+	color  node
+	stones []int
+	libs   libset
+}
+
+// libset is a set of points on a board, stored as a bitset.
+type libset []uint64
+
+func newLibset(points int) libset {
+	return make(libset, (points+63)/64)
+}
+
+func (s libset) add(idx int) {
+	s[idx/64] |= 1 << uint(idx%64)
+}
+
+func (s libset) remove(idx int) {
+	s[idx/64] &^= 1 << uint(idx%64)
+}
+
+func (s libset) union(other libset) {
+	for i := range s {
+		s[i] |= other[i]
+	}
+}
+
+func (s libset) count() int {
+	rv := 0
+	for _, w := range s {
+		rv += bits.OnesCount64(w)
+	}
+
+	return rv
+}
+
+// points returns the members of the set in increasing order.
+func (s libset) points() []int {
+	rv := make([]int, 0, s.count())
+	for i, w := range s {
+		for w != 0 {
+			rv = append(rv, i*64+bits.TrailingZeros64(w))
+			w &= w - 1
+		}
+	}
+
+	return rv
+}
+
+func containsChain(chains []*chain, c *chain) bool {
+	for _, other := range chains {
+		if other == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+// adjacent returns the on-board points next to idx. Only the first n entries
+// are valid.
+func (b *Board) adjacent(idx int) (rv [4]int, n int) {
+	x, y := b.coord(idx)
+	if y+1 < b.size {
+		rv[n] = idx + b.size
+		n++
+	}
+	if y > 0 {
+		rv[n] = idx - b.size
+		n++
+	}
+	if x+1 < b.size {
+		rv[n] = idx + 1
+		n++
+	}
+	if x > 0 {
+		rv[n] = idx - 1
+		n++
+	}
+
+	return rv, n
+}
+
+// placeStone puts a stone of color on the empty point idx, merging it with
+// the chains of the same color next to it and taking its point away from the
+// liberties of every chain it touches. Nothing is captured.
+func (b *Board) placeStone(idx int, color node) {
+	b.board[idx] = color
+
+	c := &chain{color: color, stones: []int{idx}, libs: newLibset(len(b.board))}
+	b.chains[idx] = c
+
+	adj, n := b.adjacent(idx)
+	for _, a := range adj[:n] {
+		if b.board[a] == empty {
+			c.libs.add(a)
+		}
+	}
+
+	for _, a := range adj[:n] {
+		other := b.chains[a]
+		if other == nil || other == c {
+			continue
+		}
+
+		other.libs.remove(idx)
+		if other.color == color {
+			c = b.merge(c, other)
+		}
+	}
+}
+
+// merge joins two chains of the same color, relabelling the stones of the
+// smaller one, and returns the result.
+func (b *Board) merge(c *chain, other *chain) *chain {
+	if len(c.stones) < len(other.stones) {
+		c, other = other, c
+	}
+
+	for _, idx := range other.stones {
+		b.chains[idx] = c
+	}
+
+	c.stones = append(c.stones, other.stones...)
+	c.libs.union(other.libs)
+	return c
+}
+
+// removeChain takes every stone of c off the board and gives their points
+// back to the chains around them as liberties. It returns the number of stones
+// removed.
+func (b *Board) removeChain(c *chain) int {
+	for _, idx := range c.stones {
+		b.hash ^= b.zobrist.key(idx, b.board[idx])
+		b.board[idx] = empty
+		b.chains[idx] = nil
+	}
+
+	for _, idx := range c.stones {
+		adj, n := b.adjacent(idx)
+		for _, a := range adj[:n] {
+			if other := b.chains[a]; other != nil {
+				other.libs.add(idx)
+			}
+		}
+	}
+
+	return len(c.stones)
+}
+
+// liftStone takes the single stone at idx off the board without touching
+// the rest of its chain, which may fall apart into several chains.
+func (b *Board) liftStone(idx int) {
+	c := b.chains[idx]
+	for _, s := range c.stones {
+		b.chains[s] = nil
+	}
+
+	b.board[idx] = empty
+	adj, n := b.adjacent(idx)
+	for _, a := range adj[:n] {
+		if other := b.chains[a]; other != nil {
+			other.libs.add(idx)
+		}
+	}
+
+	for _, s := range c.stones {
+		if s != idx && b.chains[s] == nil {
+			b.buildChain(s)
+		}
+	}
+}
+
+// buildChain finds the chain through the stone at idx from scratch.
+func (b *Board) buildChain(idx int) {
+	c := &chain{color: b.board[idx], libs: newLibset(len(b.board))}
+	b.chains[idx] = c
+	stack := []int{idx}
+
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		c.stones = append(c.stones, i)
+
+		adj, n := b.adjacent(i)
+		for _, a := range adj[:n] {
+			switch {
+			case b.board[a] == empty:
+				c.libs.add(a)
+			case b.board[a] == c.color && b.chains[a] == nil:
+				b.chains[a] = c
+				stack = append(stack, a)
+			}
+		}
+	}
+}
+
+// cloneChains copies the chains of b for a board with the same stones.
+func (b *Board) cloneChains() []*chain {
+	rv := make([]*chain, len(b.chains))
+	copies := make(map[*chain]*chain)
+	for idx, c := range b.chains {
+		if c == nil {
+			continue
+		}
+
+		cp, ok := copies[c]
+		if !ok {
+			cp = &chain{
+				color:  c.color,
+				stones: append([]int(nil), c.stones...),
+				libs:   append(libset(nil), c.libs...),
+			}
+			copies[c] = cp
+		}
+
+		rv[idx] = cp
+	}
+
+	return rv
+}
@@ -0,0 +1,149 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxBoardSize is the largest board every notation can name. GTP runs out of
+// column letters after 25.
+const MaxBoardSize = 25
+
+// GTPColumns are the column letters used by GTP and printed boards, which
+// skip I so it cannot be mistaken for J or 1.
+const GTPColumns = "ABCDEFGHJKLMNOPQRSTUVWXYZ"
+
+// Notation is a way of writing a point as text.
+type Notation byte
+
+const (
+	// NotationNumeric is the zero based x and y of a point, such as "3,3".
+	NotationNumeric Notation = iota
+	// NotationGTP is a column letter and a row counted from 1 at the bottom
+	// of the board, such as "D4".
+	NotationGTP
+	// NotationSGF is a letter for the column and one for the row counted
+	// from the top of the board, such as "dp".
+	NotationSGF
+)
+
+// ParsePoint reads a point on a board of size in any of the notations. Two
+// lower case letters are SGF, a letter followed by a number is GTP and two
+// numbers separated by a comma or space are numeric.
+func ParsePoint(s string, size int) (Point, error) {
+	s = strings.TrimSpace(s)
+	switch {
+	case len(s) == 2 && isLower(s[0]) && isLower(s[1]):
+		return ParsePointAs(s, NotationSGF, size)
+	case len(s) > 1 && isLetter(s[0]) && isDigit(s[1]):
+		return ParsePointAs(s, NotationGTP, size)
+	default:
+		return ParsePointAs(s, NotationNumeric, size)
+	}
+}
+
+// ParsePointAs reads a point on a board of size written in notation n.
+func ParsePointAs(s string, n Notation, size int) (Point, error) {
+	s = strings.TrimSpace(s)
+
+	var x, y int
+	var err error
+	switch n {
+	case NotationGTP:
+		if len(s) < 2 || !isDigit(s[1]) {
+			return Point{}, CoordinateError{s}
+		}
+
+		x = strings.IndexByte(GTPColumns, toUpper(s[0]))
+		y, err = strconv.Atoi(s[1:])
+		if x < 0 || err != nil {
+			return Point{}, CoordinateError{s}
+		}
+		y -= 1
+	case NotationSGF:
+		if len(s) != 2 {
+			return Point{}, CoordinateError{s}
+		}
+
+		x, y = sgfCoordValue(s[0]), size-1-sgfCoordValue(s[1])
+	default:
+		fields := strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' })
+		if len(fields) != 2 {
+			return Point{}, CoordinateError{s}
+		}
+
+		x, err = strconv.Atoi(fields[0])
+		if err == nil {
+			y, err = strconv.Atoi(fields[1])
+		}
+		if err != nil {
+			return Point{}, CoordinateError{s}
+		}
+	}
+
+	if x < 0 || y < 0 || x >= size || y >= size {
+		return Point{}, CoordinateError{s}
+	}
+
+	return Point{x, y}, nil
+}
+
+// Format writes p in notation n. Only SGF, which counts rows from the top,
+// needs the size of the board. GTP can only name points on boards up to
+// MaxBoardSize.
+func (p Point) Format(n Notation, size int) string {
+	switch n {
+	case NotationGTP:
+		return fmt.Sprintf("%c%d", GTPColumns[p.X], p.Y+1)
+	case NotationSGF:
+		return string([]byte{sgfCoord(p.X), sgfCoord(size - 1 - p.Y)})
+	default:
+		return fmt.Sprintf("%d,%d", p.X, p.Y)
+	}
+}
+
+// sgfCoord and sgfCoordValue convert between a column or row and its SGF
+// letter, a to z and then A to Z.
+func sgfCoord(v int) byte {
+	if v < 26 {
+		return byte('a' + v)
+	}
+
+	return byte('A' + v - 26)
+}
+
+func sgfCoordValue(c byte) int {
+	switch {
+	case isLower(c):
+		return int(c - 'a')
+	case isUpper(c):
+		return int(c-'A') + 26
+	default:
+		return -1
+	}
+}
+
+func isLetter(c byte) bool {
+	return isLower(c) || isUpper(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func toUpper(c byte) byte {
+	if isLower(c) {
+		return c - 'a' + 'A'
+	}
+
+	return c
+}
+
+type CoordinateError struct {
+	Value string
+}
+
+func (e CoordinateError) Error() string {
+	return fmt.Sprintf("invalid coordinate %q", e.Value)
+}
@@ -0,0 +1,62 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParsePoint(t *testing.T) {
+	testCases := []struct {
+		s        string
+		size     int
+		expected Point
+	}{
+		{"D4", 19, Point{3, 3}},
+		{"q16", 19, Point{15, 15}},
+		{"J1", 19, Point{8, 0}},
+		{"Z25", 25, Point{24, 24}},
+		{"dd", 19, Point{3, 15}},
+		{"aa", 9, Point{0, 8}},
+		{"yy", 25, Point{24, 0}},
+		{"3,3", 19, Point{3, 3}},
+		{" 0, 18 ", 19, Point{0, 18}},
+		{"4 5", 9, Point{4, 5}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.s, func(t *testing.T) {
+			p, err := ParsePoint(testCase.s, testCase.size)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, p)
+		})
+	}
+
+	for _, s := range []string{"", "I5", "T20", "U1", "A0", "D+4", "DD", "jj", "9,0", "1,2,3", "-1,0", "d"} {
+		_, err := ParsePoint(s, 9)
+		assert.Equal(t, CoordinateError{s}, err, s)
+	}
+}
+
+func TestPoint_Format(t *testing.T) {
+	for size := 1; size <= MaxBoardSize; size++ {
+		for y := 0; y < size; y++ {
+			for x := 0; x < size; x++ {
+				p := Point{x, y}
+				for _, n := range []Notation{NotationNumeric, NotationGTP, NotationSGF} {
+					s := p.Format(n, size)
+					parsed, err := ParsePointAs(s, n, size)
+					assert.NoError(t, err, s)
+					assert.Equal(t, p, parsed, s)
+
+					parsed, err = ParsePoint(s, size)
+					assert.NoError(t, err, s)
+					assert.Equal(t, p, parsed, s)
+				}
+			}
+		}
+	}
+
+	assert.Equal(t, "Q16", Point{15, 15}.Format(NotationGTP, 19))
+	assert.Equal(t, "pd", Point{15, 15}.Format(NotationSGF, 19))
+	assert.Equal(t, "15,15", Point{15, 15}.Format(NotationNumeric, 19))
+}
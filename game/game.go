@@ -1,10 +1,12 @@
 package game
 
+import "sort"
+
 type Color byte
 
-type point struct {
-	x int
-	y int
+type Point struct {
+	X int
+	Y int
 }
 
 const (
@@ -12,99 +14,415 @@ const (
 	Black Color = iota
 )
 
+func (c Color) String() string {
+	if c == White {
+		return "W"
+	}
+
+	return "B"
+}
+
+// SuperkoRule selects which repetitions of earlier positions are forbidden,
+// on top of the simple ko that is always enforced.
+type SuperkoRule byte
+
+const (
+	// SuperkoNone only forbids immediately retaking a single stone ko.
+	SuperkoNone SuperkoRule = iota
+	// SuperkoPositional forbids recreating any earlier board position.
+	SuperkoPositional
+	// SuperkoSituational forbids recreating an earlier board position with
+	// the same player to move.
+	SuperkoSituational
+)
+
+// position is an entry in the history of a game: the board hash after a move
+// and the player to move next.
+type position struct {
+	hash   uint64
+	toMove Color
+}
+
 type Game struct {
 	board        *Board
 	captures     []int
 	currentColor Color
 	move         int
-	ko           *point
+	ko           *Point
+	passes       int
+	rules        Ruleset
+	history      []position
+	// seen indexes history by hash, with a bit set for each color that was
+	// to move in that position
+	seen     map[uint64]uint8
+	handicap int
 }
 
+// NewGame starts a game under Chinese rules.
 func NewGame(size int) *Game {
-	return &Game{
-		board:        NewBoard(size),
+	return NewGameWithRules(size, ChineseRules)
+}
+
+func NewGameWithRules(size int, rules Ruleset) *Game {
+	board := NewBoard(size)
+	g := &Game{
+		board:        board,
 		captures:     make([]int, 2),
 		currentColor: Black,
 		move:         0,
+		rules:        rules,
+	}
+	g.resetHistory(position{board.Hash(), Black})
+
+	return g
+}
+
+func (g *Game) Rules() Ruleset {
+	return g.rules
+}
+
+// outcome is what playing a stone would do, worked out before the board is
+// changed.
+type outcome struct {
+	idx      int
+	toRemove []*chain
+	// suicide is set for a permitted multi-stone suicide, which removes the
+	// string the stone joins.
+	suicide bool
+	ko      *Point
+}
+
+// MoveResult describes what a move changed on the board.
+type MoveResult struct {
+	// Color is the player who moved.
+	Color Color
+	// Point is where the stone was placed, or nil for a pass.
+	Point *Point
+	// Captured holds the stones taken off the board, which after a permitted
+	// suicide are the mover's own.
+	Captured []Point
+	// Ko is the point the next player may not play at, if any.
+	Ko *Point
+	// Captures is the number of stones each color has captured so far,
+	// indexed by Color.
+	Captures [2]int
+	// Next is the player to move next.
+	Next Color
+}
+
+func (g *Game) PlayMove(x int, y int) (*MoveResult, error) {
+	o, err := g.check(x, y)
+	if err != nil {
+		return nil, err
+	}
+
+	captured := o.captured(g)
+	g.ko = o.ko
+
+	// remove all captured strings
+	for _, string := range o.toRemove {
+		removed := g.board.removeChain(string)
+		g.captures[g.currentColor] += removed
+	}
+
+	// set the node
+	g.board.SetNode(x, y, toNode(g.currentColor))
+
+	// a permitted multi-stone suicide removes the whole string it formed
+	if o.suicide {
+		removed := g.board.removeChain(g.board.chains[o.idx])
+		g.captures[opp(g.currentColor)] += removed
 	}
+
+	// swap color, increment move count
+	g.currentColor = opp(g.currentColor)
+	g.move += 1
+	g.passes = 0
+	g.record(position{g.board.Hash(), g.currentColor})
+	return g.result(&Point{x, y}, captured), nil
+}
+
+// TryMove reports what playing a stone at x, y would do without playing it.
+// It returns the stones that would be captured, which for a permitted suicide
+// are the mover's own, or the rule the move breaks.
+func (g *Game) TryMove(x int, y int) ([]Point, error) {
+	o, err := g.check(x, y)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.captured(g), nil
+}
+
+// captured returns the stones the move would take off the board, sorted by
+// row and then column.
+func (o outcome) captured(g *Game) []Point {
+	rv := make([]Point, 0)
+	for _, string := range o.toRemove {
+		for _, idx := range string.stones {
+			px, py := g.board.coord(idx)
+			rv = append(rv, Point{px, py})
+		}
+	}
+
+	if o.suicide {
+		x, y := g.board.coord(o.idx)
+		rv = append(rv, Point{x, y})
+		adj, n := g.board.adjacent(o.idx)
+		seen := make([]*chain, 0, 4)
+		for _, a := range adj[:n] {
+			string := g.board.chains[a]
+			if string == nil || string.color != toNode(g.currentColor) || containsChain(seen, string) {
+				continue
+			}
+
+			seen = append(seen, string)
+			for _, idx := range string.stones {
+				px, py := g.board.coord(idx)
+				rv = append(rv, Point{px, py})
+			}
+		}
+	}
+
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i].Y < rv[j].Y || (rv[i].Y == rv[j].Y && rv[i].X < rv[j].X)
+	})
+	return rv
+}
+
+// result describes the move that was just played at p.
+func (g *Game) result(p *Point, captured []Point) *MoveResult {
+	rv := &MoveResult{
+		Color:    opp(g.currentColor),
+		Point:    p,
+		Captured: captured,
+		Ko:       g.Ko(),
+		Next:     g.currentColor,
+	}
+	copy(rv.Captures[:], g.captures)
+	return rv
+}
+
+// LegalMoves returns every point the player to move may play a stone at.
+func (g *Game) LegalMoves() []Point {
+	rv := make([]Point, 0)
+	for y := 0; y < g.board.size; y++ {
+		for x := 0; x < g.board.size; x++ {
+			if _, err := g.check(x, y); err == nil {
+				rv = append(rv, Point{x, y})
+			}
+		}
+	}
+
+	return rv
 }
 
-func (g *Game) PlayMove(x int, y int) error {
-	// Ensure the position is empty
+// check works out the outcome of the player to move playing at x, y, or the
+// rule the move breaks. It does not change the game.
+func (g *Game) check(x int, y int) (outcome, error) {
+	// Ensure the position is on the board and empty
 	old := g.board.GetNode(x, y)
+	if old == edge {
+		return outcome{}, OutOfBoundsError{}
+	}
+
 	if old != empty {
-		return NonEmptyError{}
+		return outcome{}, NonEmptyError{}
 	}
 
-	if g.ko != nil && x == g.ko.x && y == g.ko.y {
-		return KoViolationError{}
+	if g.ko != nil && x == g.ko.X && y == g.ko.Y {
+		return outcome{}, KoViolationError{}
 	}
 
-	// count new liberties around the stone
-	north, south, east, west := g.board.GetNeighbors(x, y)
+	// count new liberties around the stone and check the surrounding strings
+	// to ensure move legality and compute captures
+	idx := g.board.idx(x, y)
+	own := toNode(g.currentColor)
 	newLiberties := 0
 	isolated := true
-	potentialSuicide := false
-	for _, neighbor := range []node{north, south, east, west} {
-		if neighbor == empty {
+	toRemove := make([]*chain, 0, 4)
+	toJoin := make([]*chain, 0, 4)
+	joinedLiberties := false
+
+	adj, n := g.board.adjacent(idx)
+	for _, a := range adj[:n] {
+		string := g.board.chains[a]
+		if string == nil {
 			newLiberties += 1
-		} else if neighbor == toNode(g.currentColor) {
+			continue
+		}
+
+		if containsChain(toRemove, string) || containsChain(toJoin, string) {
+			continue
+		}
+
+		liberties := string.libs.count()
+		if string.color != own {
+			if liberties == 1 {
+				// capture
+				toRemove = append(toRemove, string)
+			}
+		} else {
 			isolated = false
+			toJoin = append(toJoin, string)
+			if liberties > 1 {
+				joinedLiberties = true
+			}
 		}
 	}
 
-	if isolated && newLiberties == 0 {
-		potentialSuicide = true
+	// check for suicide: the new string has no liberties and captures nothing
+	suicide := len(toRemove) == 0 && newLiberties == 0 && !joinedLiberties
+	if suicide && (isolated || !g.rules.SuicideAllowed()) {
+		return outcome{}, SuicideError{}
 	}
 
-	// we have to check the surrounding groups to ensure move legality and compute captures
-	_, neighborStrings := g.board.GetStringAndNeighbors(x, y)
-	toRemove := make([]nodestring, 0)
-
-	for _, string := range neighborStrings {
-		liberties := g.board.CountLiberties(string)
-		stringColor := g.board.StringColor(string)
+	if g.rules.Superko() != SuperkoNone {
+		hash := g.board.Hash()
+		if suicide {
+			for _, string := range toJoin {
+				for _, idx := range string.stones {
+					hash ^= g.board.zobrist.key(idx, own)
+				}
+			}
+		} else {
+			hash ^= g.board.zobrist.key(idx, own)
+			for _, string := range toRemove {
+				for _, idx := range string.stones {
+					hash ^= g.board.zobrist.key(idx, toNode(opp(g.currentColor)))
+				}
+			}
+		}
 
-		if liberties == 1 && toNode(opp(g.currentColor)) == stringColor {
-			// capture
-			toRemove = append(toRemove, string)
-		} else if liberties == 1 && toNode(g.currentColor) == stringColor && newLiberties == 0 {
-			potentialSuicide = true
+		if g.repeats(position{hash, opp(g.currentColor)}) {
+			return outcome{}, SuperkoViolationError{}
 		}
 	}
 
-	// check for suicide
-	if len(toRemove) == 0 && potentialSuicide {
-		return SuicideError{}
+	rv := outcome{idx: idx, toRemove: toRemove, suicide: suicide}
+
+	// Check for new ko. Only a lone stone that captured a lone stone and has
+	// no other liberties can be retaken immediately.
+	if isolated && newLiberties == 0 && len(toRemove) == 1 && len(toRemove[0].stones) == 1 {
+		koX, koY := g.board.coord(toRemove[0].stones[0])
+		rv.ko = &Point{koX, koY}
 	}
 
-	// The move is valid at this point. Clear Ko.
+	return rv, nil
+}
+
+func (g *Game) Pass() *MoveResult {
+	if g.rules.PassStones() {
+		g.captures[opp(g.currentColor)] += 1
+	}
+
+	// a ko may be retaken once the player who was barred from it has moved
 	g.ko = nil
+	g.currentColor = opp(g.currentColor)
+	g.move += 1
+	g.passes += 1
+	g.record(position{g.board.Hash(), g.currentColor})
+	return g.result(nil, []Point{})
+}
+
+// record adds p to the history of the game.
+func (g *Game) record(p position) {
+	g.history = append(g.history, p)
+	g.seen[p.hash] |= 1 << p.toMove
+}
 
-	// Check for new ko.
-	if isolated && len(toRemove) == 1 && len(toRemove[0]) == 1 {
-		koX, koY := g.board.coord(toRemove[0][0])
-		g.ko = &point{koX, koY}
+// resetHistory starts the history of the game over from p.
+func (g *Game) resetHistory(p position) {
+	g.history = nil
+	g.seen = make(map[uint64]uint8)
+	g.record(p)
+}
+
+// repeats reports whether p has occurred before under the game's superko rule.
+func (g *Game) repeats(p position) bool {
+	toMove := g.seen[p.hash]
+	if g.rules.Superko() == SuperkoPositional {
+		return toMove != 0
 	}
 
-	// if we haven't exploded yet, remove all captured strings
-	for _, string := range toRemove {
-		removed := g.board.RemoveString(string)
-		g.captures[g.currentColor] += removed
+	return toMove&(1<<p.toMove) != 0
+}
+
+func (g *Game) CurrentColor() Color {
+	return g.currentColor
+}
+
+// SetCurrentColor gives the next move to c without a pass, for controllers
+// such as GTP that may have one color play twice in a row. Nothing is added
+// to the history or to the captures.
+func (g *Game) SetCurrentColor(c Color) {
+	if g.currentColor == c {
+		return
 	}
 
-	// set the node
-	g.board.SetNode(x, y, toNode(g.currentColor))
+	// the ko only bars the player who was to move
+	g.ko = nil
+	g.currentColor = c
+}
 
-	// swap color, increment move count
-	g.currentColor = opp(g.currentColor)
-	g.move += 1
-	return nil
+func (g *Game) MoveNumber() int {
+	return g.move
 }
 
-func (g *Game) Pass() {
-	g.currentColor = opp(g.currentColor)
-	g.move += 1
+func (g *Game) Board() *Board {
+	return g.board
+}
+
+// StringAt returns the stones connected to the stone at x, y, or nil if there
+// is no stone there.
+func (g *Game) StringAt(x int, y int) []Point {
+	string, err := g.board.GetString(x, y)
+	if err != nil {
+		return nil
+	}
+
+	rv := make([]Point, len(string))
+	for i, idx := range string {
+		px, py := g.board.coord(idx)
+		rv[i] = Point{px, py}
+	}
+
+	return rv
+}
+
+// ColorAt returns the color of the stone at x, y, and false if the point is
+// empty or off the board.
+func (g *Game) ColorAt(x int, y int) (Color, bool) {
+	switch g.board.GetNode(x, y) {
+	case black:
+		return Black, true
+	case white:
+		return White, true
+	default:
+		return 0, false
+	}
+}
+
+// Ko returns the point the player to move may not play at because it would
+// retake a ko, or nil if there is none.
+func (g *Game) Ko() *Point {
+	if g.ko == nil {
+		return nil
+	}
+
+	ko := *g.ko
+	return &ko
+}
+
+// ConsecutivePasses returns how many passes have been played since the last
+// stone.
+func (g *Game) ConsecutivePasses() int {
+	return g.passes
+}
+
+// Captures returns the number of stones captured by the player of color c.
+func (g *Game) Captures(c Color) int {
+	return g.captures[c]
 }
 
 func opp(c Color) Color {
@@ -128,12 +446,24 @@ func (e NonEmptyError) Error() string {
 	return "position is not empty"
 }
 
+type OutOfBoundsError struct{}
+
+func (e OutOfBoundsError) Error() string {
+	return "position is not on the board"
+}
+
 type KoViolationError struct{}
 
 func (e KoViolationError) Error() string {
 	return "ko violation"
 }
 
+type SuperkoViolationError struct{}
+
+func (e SuperkoViolationError) Error() string {
+	return "superko violation: move repeats an earlier position"
+}
+
 type SuicideError struct{}
 
 func (e SuicideError) Error() string {
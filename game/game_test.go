@@ -3,6 +3,7 @@ package game
 import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
 	"testing"
 )
 
@@ -15,31 +16,31 @@ type pointcolor struct {
 func TestGame_PlayMove(t *testing.T) {
 	testCases := []struct {
 		name             string
-		moves            []*point
+		moves            []*Point
 		expectedBoard    []pointcolor
 		expectedCaptures []int
 	}{
 		{
 			"add moves",
-			[]*point{{1, 1}, {2, 2}, {1, 0}, {4, 2}},
+			[]*Point{{1, 1}, {2, 2}, {1, 0}, {4, 2}},
 			[]pointcolor{{1, 1, black}, {2, 2, white}, {1, 0, black}, {4, 2, white}},
 			[]int{0, 0},
 		},
 		{
 			"capture corner",
-			[]*point{{0, 0}, {1, 0}, nil, {0, 1}},
+			[]*Point{{0, 0}, {1, 0}, nil, {0, 1}},
 			[]pointcolor{{0, 0, empty}, {1, 0, white}, {0, 1, white}},
 			[]int{0, 1},
 		},
 		{
 			"capture side",
-			[]*point{{1, 0}, {0, 0}, {2, 0}, {1, 1}, nil, {2, 1}, nil, {3, 0}},
+			[]*Point{{1, 0}, {0, 0}, {2, 0}, {1, 1}, nil, {2, 1}, nil, {3, 0}},
 			[]pointcolor{{1, 0, empty}, {0, 0, white}, {2, 0, empty}, {1, 1, white}, {2, 1, white}, {3, 0, white}},
 			[]int{0, 2},
 		},
 		{
 			"capture center",
-			[]*point{{2, 2}, {2, 1}, {3, 2}, {3, 1}, {2, 3}, {1, 2}, {3, 3}, {1, 3}, nil, {2, 4}, nil, {3, 4}, nil, {4, 3}, nil, {4, 2}},
+			[]*Point{{2, 2}, {2, 1}, {3, 2}, {3, 1}, {2, 3}, {1, 2}, {3, 3}, {1, 3}, nil, {2, 4}, nil, {3, 4}, nil, {4, 3}, nil, {4, 2}},
 			[]pointcolor{{2, 2, empty}, {3, 2, empty}, {2, 3, empty}, {3, 3, empty}, {1, 2, white}, {1, 3, white}, {2, 4, white}, {3, 4, white}, {4, 3, white}, {4, 2, white}, {2, 1, white}, {3, 1, white}},
 			[]int{0, 4},
 		},
@@ -52,7 +53,7 @@ func TestGame_PlayMove(t *testing.T) {
 				if move == nil {
 					game.Pass()
 				} else {
-					assert.Nil(t, game.PlayMove(move.x, move.y))
+					assert.Nil(t, play(game, move.X, move.Y))
 				}
 			}
 
@@ -61,52 +62,192 @@ func TestGame_PlayMove(t *testing.T) {
 			}
 
 			assert.Equal(t, len(testCase.moves), game.move)
+			assert.Equal(t, testCase.expectedCaptures, []int{game.Captures(Black), game.Captures(White)})
 		})
 	}
 }
 
+func TestGame_PlayMove_Result(t *testing.T) {
+	game := NewGame(5)
+	for _, move := range []Point{{2, 2}, {1, 2}, {3, 3}, {2, 3}, {4, 2}, {2, 1}, {3, 1}} {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+
+	result, err := game.PlayMove(3, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, &MoveResult{
+		Color:    White,
+		Point:    &Point{3, 2},
+		Captured: []Point{{2, 2}},
+		Ko:       &Point{2, 2},
+		Captures: [2]int{1, 0},
+		Next:     Black,
+	}, result)
+
+	result = game.Pass()
+	assert.Equal(t, Black, result.Color)
+	assert.Nil(t, result.Point)
+	assert.Empty(t, result.Captured)
+	assert.Nil(t, result.Ko)
+	assert.Equal(t, White, result.Next)
+}
+
 func TestGame_PlayMove_ExistingStone(t *testing.T) {
 	game := NewGame(5)
-	assert.Nil(t, game.PlayMove(2, 2))
-	err := game.PlayMove(2, 2)
+	assert.Nil(t, play(game, 2, 2))
+	_, err := game.PlayMove(2, 2)
 	assert.EqualError(t, err, NonEmptyError{}.Error())
 }
 
+func TestGame_PlayMove_OutOfBounds(t *testing.T) {
+	game := NewGame(5)
+	assert.EqualError(t, play(game, 5, 2), OutOfBoundsError{}.Error())
+	assert.EqualError(t, play(game, -1, 0), OutOfBoundsError{}.Error())
+	assert.Equal(t, Black, game.CurrentColor())
+	assert.Equal(t, 0, game.MoveNumber())
+}
+
+func TestGame_StringAt(t *testing.T) {
+	game := NewGame(5)
+	assert.Nil(t, play(game, 2, 2))
+	assert.Nil(t, play(game, 0, 0))
+	assert.Nil(t, play(game, 2, 3))
+
+	assert.Equal(t, []Point{{2, 2}, {2, 3}}, game.StringAt(2, 3))
+	assert.Equal(t, []Point{{0, 0}}, game.StringAt(0, 0))
+	assert.Nil(t, game.StringAt(4, 4))
+}
+
+func TestGame_ColorAt(t *testing.T) {
+	game := NewGame(5)
+	assert.Nil(t, play(game, 1, 1))
+	assert.Nil(t, play(game, 2, 2))
+
+	c, ok := game.ColorAt(1, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Black, c)
+
+	c, ok = game.ColorAt(2, 2)
+	assert.True(t, ok)
+	assert.Equal(t, White, c)
+
+	_, ok = game.ColorAt(3, 3)
+	assert.False(t, ok)
+	_, ok = game.ColorAt(5, 0)
+	assert.False(t, ok)
+}
+
+func TestGame_TryMove(t *testing.T) {
+	game := NewGame(5)
+	for _, move := range []Point{{1, 0}, {0, 0}, {2, 0}, {1, 1}, {4, 4}} {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+
+	captures, err := game.TryMove(2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, []Point{}, captures)
+	assert.Nil(t, play(game, 2, 1))
+	assert.Nil(t, play(game, 4, 3))
+
+	// white can now capture the two black stones on the edge
+	captures, err = game.TryMove(3, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []Point{{1, 0}, {2, 0}}, captures)
+	assert.Equal(t, black, game.board.GetNode(1, 0))
+	assert.Equal(t, White, game.CurrentColor())
+
+	_, err = game.TryMove(0, 0)
+	assert.EqualError(t, err, NonEmptyError{}.Error())
+}
+
+func TestGame_TryMove_Suicide(t *testing.T) {
+	game := NewGameWithRules(3, NewZealandRules)
+	for _, move := range []*Point{{0, 0}, {0, 1}, nil, {1, 1}, nil, {2, 0}} {
+		if move == nil {
+			game.Pass()
+		} else {
+			assert.Nil(t, play(game, move.X, move.Y))
+		}
+	}
+
+	captures, err := game.TryMove(1, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []Point{{0, 0}, {1, 0}}, captures)
+	assert.Equal(t, black, game.board.GetNode(0, 0))
+}
+
+func TestGame_LegalMoves(t *testing.T) {
+	game := NewGame(5)
+	assert.Len(t, game.LegalMoves(), 25)
+
+	for _, move := range []Point{{2, 2}, {1, 2}, {3, 3}, {2, 3}, {4, 2}, {2, 1}, {3, 1}, {3, 2}} {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+
+	// seven stones are left on the board and the ko forbids black from
+	// retaking at 2, 2
+	legal := game.LegalMoves()
+	assert.Len(t, legal, 17)
+	assert.NotContains(t, legal, Point{2, 2})
+	assert.Contains(t, legal, Point{0, 0})
+}
+
 func TestGame_Pass(t *testing.T) {
 	game := NewGame(5)
 	game.Pass()
 	assert.Equal(t, game.currentColor, White)
 	assert.Equal(t, game.move, 1)
+	assert.Equal(t, 1, game.ConsecutivePasses())
+
+	game.Pass()
+	assert.Equal(t, 2, game.ConsecutivePasses())
+
+	assert.Nil(t, play(game, 2, 2))
+	assert.Equal(t, 0, game.ConsecutivePasses())
+}
+
+func TestGame_SetCurrentColor(t *testing.T) {
+	game := NewGameWithRules(5, AGARules)
+	assert.Nil(t, play(game, 2, 2))
+	game.SetCurrentColor(Black)
+	assert.Nil(t, play(game, 3, 3))
+
+	assert.Equal(t, White, game.CurrentColor())
+	assert.Equal(t, 2, game.MoveNumber())
+	assert.Equal(t, 0, game.ConsecutivePasses())
+	assert.Equal(t, 0, game.Captures(Black))
+	assert.Equal(t, 0, game.Captures(White))
+	assert.Len(t, game.history, 3)
 }
 
 func TestGame_PlayMove_Suicide(t *testing.T) {
 	testCases := []struct {
 		name  string
-		moves []*point
+		moves []*Point
 	}{
 		{
 			"suicide in corner single stone",
-			[]*point{{0, 1}, nil, {1, 0}, {0, 0}},
+			[]*Point{{0, 1}, nil, {1, 0}, {0, 0}},
 		},
 		{
 			"suicide in corner string",
-			[]*point{{0, 2}, {0, 0}, {2, 0}, {1, 0}, {1, 1}, {0, 1}},
+			[]*Point{{0, 2}, {0, 0}, {2, 0}, {1, 0}, {1, 1}, {0, 1}},
 		},
 		{
 			"suicide on edge single stone",
-			[]*point{nil, {1, 0}, nil, {2, 1}, nil, {3, 0}, {2, 0}},
+			[]*Point{nil, {1, 0}, nil, {2, 1}, nil, {3, 0}, {2, 0}},
 		},
 		{
 			"suicide on edge string",
-			[]*point{{1, 0}, {0, 0}, {2, 0}, {1, 1}, nil, {2, 1}, nil, {3, 1}, nil, {4, 0}, {3, 0}},
+			[]*Point{{1, 0}, {0, 0}, {2, 0}, {1, 1}, nil, {2, 1}, nil, {3, 1}, nil, {4, 0}, {3, 0}},
 		},
 		{
 			"suicide in center single stone",
-			[]*point{nil, {0, 2}, nil, {1, 3}, nil, {2, 2}, nil, {1, 1}, {1, 2}},
+			[]*Point{nil, {0, 2}, nil, {1, 3}, nil, {2, 2}, nil, {1, 1}, {1, 2}},
 		},
 		{
 			"suicide in center string",
-			[]*point{nil, {0, 2}, {1, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 2}, {3, 3}, {2, 1}, {4, 2}, nil, {3, 1}, nil, {2, 0}, nil, {1, 1}, {2, 2}},
+			[]*Point{nil, {0, 2}, {1, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 2}, {3, 3}, {2, 1}, {4, 2}, nil, {3, 1}, nil, {2, 0}, nil, {1, 1}, {2, 2}},
 		},
 	}
 
@@ -118,7 +259,7 @@ func TestGame_PlayMove_Suicide(t *testing.T) {
 				if move == nil {
 					game.Pass()
 				} else {
-					err = game.PlayMove(move.x, move.y)
+					_, err = game.PlayMove(move.X, move.Y)
 				}
 			}
 
@@ -127,32 +268,179 @@ func TestGame_PlayMove_Suicide(t *testing.T) {
 	}
 }
 
+func TestGame_PlayMove_MultiStoneSuicide(t *testing.T) {
+	moves := []*Point{{0, 2}, {0, 0}, {2, 0}, {1, 0}, {1, 1}, {0, 1}}
+
+	game := NewGameWithRules(5, ChineseRules)
+	var err error
+	for _, move := range moves {
+		_, err = game.PlayMove(move.X, move.Y)
+	}
+	assert.EqualError(t, err, SuicideError{}.Error())
+
+	game = NewGameWithRules(5, NewZealandRules)
+	for _, move := range moves {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+	assert.Equal(t, empty, game.board.GetNode(0, 0))
+	assert.Equal(t, empty, game.board.GetNode(1, 0))
+	assert.Equal(t, empty, game.board.GetNode(0, 1))
+	assert.Equal(t, 3, game.Captures(Black))
+	assert.Equal(t, Black, game.CurrentColor())
+
+	// single stone suicide is never allowed
+	game = NewGameWithRules(5, TrompTaylorRules)
+	for _, move := range []*Point{{0, 1}, {4, 4}, {1, 0}} {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+	assert.EqualError(t, play(game, 0, 0), SuicideError{}.Error())
+}
+
+func TestGame_PlayMove_JoinString(t *testing.T) {
+	// white fills its own last liberty at (0, 0) but joins a string that has
+	// other liberties, which is not suicide
+	game := NewGame(5)
+	for _, move := range []*Point{{2, 0}, {1, 0}, {1, 1}, {0, 1}, {2, 2}, {0, 2}, {4, 4}, {1, 2}} {
+		assert.Nil(t, play(game, move.X, move.Y))
+	}
+
+	game.Pass()
+	assert.Nil(t, play(game, 0, 0))
+	assert.Equal(t, white, game.board.GetNode(0, 0))
+}
+
+func TestGame_Pass_PassStones(t *testing.T) {
+	game := NewGameWithRules(5, AGARules)
+	game.Pass()
+	game.Pass()
+	assert.Equal(t, 1, game.Captures(White))
+	assert.Equal(t, 1, game.Captures(Black))
+
+	game = NewGameWithRules(5, JapaneseRules)
+	game.Pass()
+	assert.Equal(t, 0, game.Captures(White))
+}
+
 func TestGame_PlayMove_Ko(t *testing.T) {
 	game := NewGame(5)
-	assert.Nil(t, game.PlayMove(2, 2))
-	assert.Nil(t, game.PlayMove(1, 2))
-	assert.Nil(t, game.PlayMove(3, 3))
-	assert.Nil(t, game.PlayMove(2, 3))
-	assert.Nil(t, game.PlayMove(4, 2))
-	assert.Nil(t, game.PlayMove(2, 1))
-	assert.Nil(t, game.PlayMove(3, 1))
-	assert.Nil(t, game.PlayMove(3, 2))
+	assert.Nil(t, play(game, 2, 2))
+	assert.Nil(t, play(game, 1, 2))
+	assert.Nil(t, play(game, 3, 3))
+	assert.Nil(t, play(game, 2, 3))
+	assert.Nil(t, play(game, 4, 2))
+	assert.Nil(t, play(game, 2, 1))
+	assert.Nil(t, play(game, 3, 1))
+	assert.Nil(t, play(game, 3, 2))
 
 	assert.NotNil(t, game.ko)
-	assert.Equal(t, game.ko.x, 2)
-	assert.Equal(t, game.ko.y, 2)
+	assert.Equal(t, game.ko.X, 2)
+	assert.Equal(t, game.ko.Y, 2)
+	assert.Equal(t, &Point{2, 2}, game.Ko())
 
-	err := game.PlayMove(2, 2)
+	_, err := game.PlayMove(2, 2)
 	assert.EqualError(t, err, KoViolationError{}.Error())
 
-	assert.Nil(t, game.PlayMove(4, 4))
+	assert.Nil(t, play(game, 4, 4))
 	assert.Nil(t, game.ko)
+	assert.Nil(t, game.Ko())
 	game.Pass()
-	assert.Nil(t, game.PlayMove(2, 2))
+	assert.Nil(t, play(game, 2, 2))
 
 	assert.NotNil(t, game.ko)
-	assert.Equal(t, game.ko.x, 3)
-	assert.Equal(t, game.ko.y, 2)
+	assert.Equal(t, game.ko.X, 3)
+	assert.Equal(t, game.ko.Y, 2)
+}
+
+func TestGame_PlayMove_Superko(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    Ruleset
+		expected error
+	}{
+		{"no superko", JapaneseRules, nil},
+		{"positional", ChineseRules, SuperkoViolationError{}},
+		{"situational", NewZealandRules, SuperkoViolationError{}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			game := NewGameWithRules(5, testCase.rules)
+			for _, move := range []*Point{{2, 2}, {1, 2}, {3, 3}, {2, 3}, {4, 2}, {2, 1}, {3, 1}, {3, 2}} {
+				assert.Nil(t, play(game, move.X, move.Y))
+			}
+
+			// both players pass, lifting the simple ko, and black retakes,
+			// which recreates the position after black's (3, 1)
+			game.Pass()
+			game.Pass()
+			_, err := game.PlayMove(2, 2)
+			if testCase.expected == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.expected.Error())
+			}
+		})
+	}
+}
+
+func TestGame_repeats(t *testing.T) {
+	game := NewGameWithRules(5, TrompTaylorRules)
+	game.resetHistory(position{42, Black})
+
+	assert.True(t, game.repeats(position{42, Black}))
+	assert.True(t, game.repeats(position{42, White}))
+	assert.False(t, game.repeats(position{43, Black}))
+
+	game = NewGameWithRules(5, AGARules)
+	game.resetHistory(position{42, Black})
+	assert.True(t, game.repeats(position{42, Black}))
+	assert.False(t, game.repeats(position{42, White}))
+}
+
+// play plays a stone for tests that only care whether the move is legal.
+func play(g *Game, x int, y int) error {
+	_, err := g.PlayMove(x, y)
+	return err
+}
+
+// randomGame plays up to n random legal moves on an empty board and returns
+// them, so benchmarks can replay a long game with plenty of captures.
+func randomGame(size int, n int, seed int64) []Point {
+	r := rand.New(rand.NewSource(seed))
+	game := NewGame(size)
+	rv := make([]Point, 0, n)
+	for len(rv) < n {
+		played := false
+		for attempt := 0; attempt < 100 && !played; attempt++ {
+			p := Point{r.Intn(size), r.Intn(size)}
+			if play(game, p.X, p.Y) == nil {
+				rv = append(rv, p)
+				played = true
+			}
+		}
+
+		if !played {
+			break
+		}
+	}
+
+	return rv
+}
+
+func BenchmarkGame_PlayMove(b *testing.B) {
+	for _, size := range []int{9, 19} {
+		moves := randomGame(size, size*size*2, 1)
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				game := NewGame(size)
+				for _, move := range moves {
+					if _, err := game.PlayMove(move.X, move.Y); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+	}
 }
 
 func printGame(game *Game) {
@@ -160,7 +448,7 @@ func printGame(game *Game) {
 		for x := 0; x < game.board.size; x++ {
 			node := game.board.board[game.board.idx(x, y)]
 			var nodeStr = "."
-			if game.ko != nil && game.ko.x == x && game.ko.y == y {
+			if game.ko != nil && game.ko.X == x && game.ko.Y == y {
 				nodeStr = "X"
 			} else if node == white {
 				nodeStr = "W"
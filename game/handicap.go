@@ -0,0 +1,101 @@
+package game
+
+import "fmt"
+
+const (
+	MinHandicap = 2
+	MaxHandicap = 9
+)
+
+// HandicapPoints returns the standard star point placement of n handicap
+// stones, in the order the GTP fixed_handicap command places them. Fixed
+// placement is only defined on 9x9, 13x13 and 19x19 boards.
+func HandicapPoints(size int, n int) ([]Point, error) {
+	if size != 9 && size != 13 && size != 19 {
+		return nil, HandicapError{fmt.Sprintf("no fixed handicap placement on %dx%d", size, size)}
+	}
+
+	if n < MinHandicap || n > MaxHandicap {
+		return nil, HandicapError{fmt.Sprintf("handicap must be between %d and %d stones", MinHandicap, MaxHandicap)}
+	}
+
+	edge := 3
+	if size < 13 {
+		edge = 2
+	}
+
+	low, mid, high := edge, size/2, size-1-edge
+	corners := []Point{{low, low}, {high, high}, {low, high}, {high, low}}
+	sides := []Point{{low, mid}, {high, mid}}
+	center := Point{mid, mid}
+
+	switch n {
+	case 2, 3, 4:
+		return corners[:n], nil
+	case 5:
+		return append(corners, center), nil
+	case 6:
+		return append(corners, sides...), nil
+	case 7:
+		return append(append(corners, sides...), center), nil
+	default:
+		rv := append(append(corners, sides...), Point{mid, low}, Point{mid, high})
+		if n == 9 {
+			rv = append(rv, center)
+		}
+
+		return rv, nil
+	}
+}
+
+// PlaceHandicap puts Black's handicap stones on the board before the first
+// move. White moves first afterwards.
+func (g *Game) PlaceHandicap(points []Point) error {
+	if g.move > 0 || g.handicap > 0 {
+		return HandicapError{"handicap stones can only be placed before the first move"}
+	}
+
+	if len(points) < MinHandicap || len(points) > MaxHandicap {
+		return HandicapError{fmt.Sprintf("handicap must be between %d and %d stones", MinHandicap, MaxHandicap)}
+	}
+
+	// check every point before placing any, so a bad one leaves the board as
+	// it was
+	seen := make(map[Point]bool, len(points))
+	for _, p := range points {
+		switch g.board.GetNode(p.X, p.Y) {
+		case edge:
+			return OutOfBoundsError{}
+		case empty:
+		default:
+			return NonEmptyError{}
+		}
+
+		if seen[p] {
+			return HandicapError{fmt.Sprintf("handicap stone placed twice at %d,%d", p.X, p.Y)}
+		}
+		seen[p] = true
+	}
+
+	for _, p := range points {
+		g.board.SetNode(p.X, p.Y, black)
+	}
+
+	g.handicap = len(points)
+	g.currentColor = White
+	g.resetHistory(position{g.board.Hash(), White})
+	return nil
+}
+
+// Handicap returns the number of handicap stones Black started with.
+func (g *Game) Handicap() int {
+	return g.handicap
+}
+
+type HandicapError struct {
+	Reason string
+}
+
+func (e HandicapError) Error() string {
+	return "invalid handicap: " + e.Reason
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHandicapPoints(t *testing.T) {
+	testCases := []struct {
+		name     string
+		size     int
+		n        int
+		expected []Point
+	}{
+		{"19x19 two stones", 19, 2, []Point{{3, 3}, {15, 15}}},
+		{"19x19 five stones", 19, 5, []Point{{3, 3}, {15, 15}, {3, 15}, {15, 3}, {9, 9}}},
+		{"13x13 six stones", 13, 6, []Point{{3, 3}, {9, 9}, {3, 9}, {9, 3}, {3, 6}, {9, 6}}},
+		{"9x9 nine stones", 9, 9, []Point{{2, 2}, {6, 6}, {2, 6}, {6, 2}, {2, 4}, {6, 4}, {4, 2}, {4, 6}, {4, 4}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			points, err := HandicapPoints(testCase.size, testCase.n)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, points)
+		})
+	}
+
+	_, err := HandicapPoints(15, 4)
+	assert.IsType(t, HandicapError{}, err)
+
+	_, err = HandicapPoints(19, 10)
+	assert.IsType(t, HandicapError{}, err)
+}
+
+func TestGame_PlaceHandicap(t *testing.T) {
+	g := NewGame(9)
+	points, err := HandicapPoints(9, 4)
+	assert.NoError(t, err)
+
+	err = g.PlaceHandicap(points)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, g.Handicap())
+	assert.Equal(t, White, g.CurrentColor())
+	assert.Equal(t, black, g.Board().GetNode(2, 2))
+
+	err = g.PlaceHandicap(points)
+	assert.IsType(t, HandicapError{}, err)
+
+	assert.NoError(t, play(g, 4, 4))
+	assert.Equal(t, Black, g.CurrentColor())
+
+	g = NewGame(9)
+	err = g.PlaceHandicap([]Point{{0, 0}, {0, 0}})
+	assert.IsType(t, HandicapError{}, err)
+	assert.Equal(t, empty, g.Board().GetNode(0, 0))
+	assert.Equal(t, Black, g.CurrentColor())
+
+	g = NewGame(9)
+	err = g.PlaceHandicap([]Point{{0, 0}, {9, 9}})
+	assert.IsType(t, OutOfBoundsError{}, err)
+	assert.Equal(t, empty, g.Board().GetNode(0, 0))
+
+	g = NewGame(9)
+	assert.NoError(t, play(g, 4, 4))
+	err = g.PlaceHandicap(points)
+	assert.IsType(t, HandicapError{}, err)
+}
+
+func TestGame_ScoreHandicap(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    Ruleset
+		expected float64
+	}{
+		{"chinese compensates white", ChineseRules, 2},
+		{"japanese does not", JapaneseRules, 0},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewGameWithRules(9, testCase.rules)
+			assert.NoError(t, g.PlaceHandicap([]Point{{2, 2}, {6, 6}}))
+
+			score := g.Score(nil, 0)
+			assert.Equal(t, testCase.expected, score.Total(White))
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// renderCell is the distance between lines of a rendered board, in
+	// pixels.
+	renderCell = 24
+	// renderMargin is the space around the grid, which holds the
+	// coordinates clear of the stones on the edge.
+	renderMargin = 40
+	// renderStone is the radius of a stone, leaving a pixel between stones.
+	renderStone = renderCell/2 - 1
+)
+
+var (
+	boardColor = color.RGBA{0xdc, 0xb3, 0x5c, 0xff}
+	blackColor = color.RGBA{0x00, 0x00, 0x00, 0xff}
+	whiteColor = color.RGBA{0xff, 0xff, 0xff, 0xff}
+)
+
+// renderLayout places a board of size in a rendered image, with y = 0 at the
+// bottom.
+type renderLayout struct {
+	size int
+}
+
+func (l renderLayout) width() int {
+	return 2*renderMargin + (l.size-1)*renderCell
+}
+
+func (l renderLayout) x(x int) int {
+	return renderMargin + x*renderCell
+}
+
+func (l renderLayout) y(y int) int {
+	return renderMargin + (l.size-1-y)*renderCell
+}
+
+// starPoints returns the points marked on the grid of a board of size.
+func starPoints(size int) []Point {
+	n := 9
+	if size == 9 {
+		n = 5
+	}
+
+	rv, err := HandicapPoints(size, n)
+	if err != nil {
+		return nil
+	}
+
+	return rv
+}
+
+// columnLabel and rowLabel are the coordinates written beside the grid, in
+// GTP notation. Boards larger than MaxBoardSize get no coordinates.
+func columnLabel(x int) string {
+	return string(GTPColumns[x])
+}
+
+func rowLabel(y int) string {
+	return strconv.Itoa(y + 1)
+}
+
+// WriteSVG draws b to w as an SVG image, with coordinates around the grid
+// and last, if it is not nil, marked as the last move played.
+func WriteSVG(w io.Writer, b *Board, last *Point) error {
+	var sb strings.Builder
+	l := renderLayout{b.size}
+	width := l.width()
+
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, width, width, width)
+	fmt.Fprintf(&sb, `<rect width="%d" height="%d" fill="%s"/>`, width, width, svgColor(boardColor))
+
+	sb.WriteString(`<path stroke="#000" stroke-width="1" d="`)
+	first, end := l.x(0), l.x(b.size-1)
+	for i := 0; i < b.size; i++ {
+		p := l.x(i)
+		fmt.Fprintf(&sb, "M%d %dH%dM%d %dV%d", first, p, end, p, first, end)
+	}
+	sb.WriteString(`"/>`)
+
+	for _, p := range starPoints(b.size) {
+		fmt.Fprintf(&sb, `<circle cx="%d" cy="%d" r="3"/>`, l.x(p.X), l.y(p.Y))
+	}
+
+	if b.size <= MaxBoardSize {
+		sb.WriteString(`<g font-family="sans-serif" font-size="11" text-anchor="middle" dominant-baseline="central">`)
+		for i := 0; i < b.size; i++ {
+			for _, at := range []int{renderMargin / 2, width - renderMargin/2} {
+				fmt.Fprintf(&sb, `<text x="%d" y="%d">%s</text>`, l.x(i), at, columnLabel(i))
+				fmt.Fprintf(&sb, `<text x="%d" y="%d">%s</text>`, at, l.y(i), rowLabel(i))
+			}
+		}
+		sb.WriteString(`</g>`)
+	}
+
+	for y := 0; y < b.size; y++ {
+		for x := 0; x < b.size; x++ {
+			switch b.GetNode(x, y) {
+			case black:
+				fmt.Fprintf(&sb, `<circle cx="%d" cy="%d" r="%d"/>`, l.x(x), l.y(y), renderStone)
+			case white:
+				fmt.Fprintf(&sb, `<circle cx="%d" cy="%d" r="%d" fill="#fff" stroke="#000"/>`, l.x(x), l.y(y), renderStone)
+			}
+		}
+	}
+
+	if marker, ok := lastMoveColor(b, last); ok {
+		fmt.Fprintf(&sb, `<circle cx="%d" cy="%d" r="%d" fill="none" stroke="%s" stroke-width="2"/>`, l.x(last.X), l.y(last.Y), renderCell/4, svgColor(marker))
+	}
+
+	sb.WriteString("</svg>\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func svgColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// lastMoveColor returns the color to mark the stone at last in, which is the
+// opposite of the stone's so it shows up.
+func lastMoveColor(b *Board, last *Point) (color.RGBA, bool) {
+	if last == nil {
+		return color.RGBA{}, false
+	}
+
+	switch b.GetNode(last.X, last.Y) {
+	case black:
+		return whiteColor, true
+	case white:
+		return blackColor, true
+	default:
+		return color.RGBA{}, false
+	}
+}
+
+// RenderImage draws b as WriteSVG does, as a bitmap.
+func RenderImage(b *Board, last *Point) *image.RGBA {
+	l := renderLayout{b.size}
+	width := l.width()
+	img := image.NewRGBA(image.Rect(0, 0, width, width))
+	fillRect(img, img.Bounds(), boardColor)
+
+	first, end := l.x(0), l.x(b.size-1)
+	for i := 0; i < b.size; i++ {
+		p := l.x(i)
+		fillRect(img, image.Rect(first, p, end+1, p+1), blackColor)
+		fillRect(img, image.Rect(p, first, p+1, end+1), blackColor)
+	}
+
+	for _, p := range starPoints(b.size) {
+		fillCircle(img, l.x(p.X), l.y(p.Y), 3, blackColor)
+	}
+
+	if b.size <= MaxBoardSize {
+		for i := 0; i < b.size; i++ {
+			for _, at := range []int{renderMargin / 2, width - renderMargin/2} {
+				drawLabel(img, l.x(i), at, columnLabel(i))
+				drawLabel(img, at, l.y(i), rowLabel(i))
+			}
+		}
+	}
+
+	for y := 0; y < b.size; y++ {
+		for x := 0; x < b.size; x++ {
+			switch b.GetNode(x, y) {
+			case black:
+				fillCircle(img, l.x(x), l.y(y), renderStone, blackColor)
+			case white:
+				fillCircle(img, l.x(x), l.y(y), renderStone, blackColor)
+				fillCircle(img, l.x(x), l.y(y), renderStone-1, whiteColor)
+			}
+		}
+	}
+
+	if marker, ok := lastMoveColor(b, last); ok {
+		stone := blackColor
+		if marker == blackColor {
+			stone = whiteColor
+		}
+
+		fillCircle(img, l.x(last.X), l.y(last.Y), renderCell/4+1, marker)
+		fillCircle(img, l.x(last.X), l.y(last.Y), renderCell/4-1, stone)
+	}
+
+	return img
+}
+
+// WritePNG draws b to w as a PNG image.
+func WritePNG(w io.Writer, b *Board, last *Point) error {
+	return png.Encode(w, RenderImage(b, last))
+}
+
+func fillRect(img *image.RGBA, r image.Rectangle, c color.RGBA) {
+	r = r.Intersect(img.Bounds())
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
+
+// fillCircle draws a circle centred on the middle of the pixel at cx, cy,
+// blending the pixels on its edge by how much of them it covers.
+func fillCircle(img *image.RGBA, cx int, cy int, r int, c color.RGBA) {
+	bounds := image.Rect(cx-r-1, cy-r-1, cx+r+2, cy+r+2).Intersect(img.Bounds())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			d := math.Hypot(float64(x-cx), float64(y-cy))
+			coverage := math.Max(0, math.Min(1, float64(r)+0.5-d))
+			if coverage > 0 {
+				img.SetRGBA(x, y, blend(img.RGBAAt(x, y), c, coverage))
+			}
+		}
+	}
+}
+
+func blend(under color.RGBA, over color.RGBA, alpha float64) color.RGBA {
+	mix := func(a uint8, b uint8) uint8 {
+		return uint8(math.Round(float64(a)*(1-alpha) + float64(b)*alpha))
+	}
+
+	return color.RGBA{mix(under.R, over.R), mix(under.G, over.G), mix(under.B, over.B), 0xff}
+}
+
+const (
+	// glyphScale is how many pixels wide each dot of a glyph is drawn.
+	glyphScale = 2
+	glyphWidth = 3
+	// glyphHeight is the number of rows in a glyph.
+	glyphHeight = 5
+)
+
+// glyphs is a 3x5 pixel font for the coordinates, since the standard library
+// has no way to draw text. Each row holds three bits, the highest on the
+// left.
+var glyphs = map[byte][glyphHeight]uint8{
+	'0': {7, 5, 5, 5, 7}, '1': {2, 6, 2, 2, 7}, '2': {7, 1, 7, 4, 7}, '3': {7, 1, 7, 1, 7},
+	'4': {5, 5, 7, 1, 1}, '5': {7, 4, 7, 1, 7}, '6': {7, 4, 7, 5, 7}, '7': {7, 1, 1, 1, 1},
+	'8': {7, 5, 7, 5, 7}, '9': {7, 5, 7, 1, 7},
+	'A': {2, 5, 7, 5, 5}, 'B': {6, 5, 6, 5, 6}, 'C': {3, 4, 4, 4, 3}, 'D': {6, 5, 5, 5, 6},
+	'E': {7, 4, 6, 4, 7}, 'F': {7, 4, 6, 4, 4}, 'G': {3, 4, 5, 5, 3}, 'H': {5, 5, 7, 5, 5},
+	'J': {1, 1, 1, 5, 2}, 'K': {5, 5, 6, 5, 5}, 'L': {4, 4, 4, 4, 7}, 'M': {5, 7, 7, 5, 5},
+	'N': {6, 5, 5, 5, 5}, 'O': {2, 5, 5, 5, 2}, 'P': {6, 5, 6, 4, 4}, 'Q': {2, 5, 5, 6, 3},
+	'R': {6, 5, 6, 5, 5}, 'S': {3, 4, 2, 1, 6}, 'T': {7, 2, 2, 2, 2}, 'U': {5, 5, 5, 5, 7},
+	'V': {5, 5, 5, 5, 2}, 'W': {5, 5, 7, 7, 5}, 'X': {5, 5, 2, 5, 5}, 'Y': {5, 5, 2, 2, 2},
+	'Z': {7, 1, 2, 4, 7},
+}
+
+// drawLabel writes s centred on cx, cy.
+func drawLabel(img *image.RGBA, cx int, cy int, s string) {
+	advance := (glyphWidth + 1) * glyphScale
+	width := len(s)*advance - glyphScale
+	left, top := cx-width/2, cy-glyphHeight*glyphScale/2
+
+	for i := 0; i < len(s); i++ {
+		glyph := glyphs[s[i]]
+		for row, bits := range glyph {
+			for col := 0; col < glyphWidth; col++ {
+				if bits&(1<<uint(glyphWidth-1-col)) == 0 {
+					continue
+				}
+
+				x, y := left+i*advance+col*glyphScale, top+row*glyphScale
+				fillRect(img, image.Rect(x, y, x+glyphScale, y+glyphScale), blackColor)
+			}
+		}
+	}
+}
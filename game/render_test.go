@@ -0,0 +1,72 @@
+package game
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func renderTestGame(t *testing.T) *Game {
+	g := NewGame(9)
+	assert.NoError(t, play(g, 2, 2))
+	assert.NoError(t, play(g, 6, 6))
+	return g
+}
+
+func TestWriteSVG(t *testing.T) {
+	g := renderTestGame(t)
+
+	var buf bytes.Buffer
+	err := WriteSVG(&buf, g.Board(), &Point{6, 6})
+	assert.NoError(t, err)
+
+	svg := buf.String()
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="272" height="272"`))
+	// black at C3 and white at G7, with the white stone marked in black
+	assert.Contains(t, svg, `<circle cx="88" cy="184" r="11"/>`)
+	assert.Contains(t, svg, `<circle cx="184" cy="88" r="11" fill="#fff" stroke="#000"/>`)
+	assert.Contains(t, svg, `<circle cx="184" cy="88" r="6" fill="none" stroke="#000000" stroke-width="2"/>`)
+	assert.Contains(t, svg, `<text x="40" y="20">A</text>`)
+	assert.Contains(t, svg, `<text x="20" y="40">9</text>`)
+	assert.Equal(t, 5, strings.Count(svg, `r="3"`))
+}
+
+func TestRenderImage(t *testing.T) {
+	g := renderTestGame(t)
+
+	var buf bytes.Buffer
+	err := WritePNG(&buf, g.Board(), &Point{6, 6})
+	assert.NoError(t, err)
+
+	img, err := png.Decode(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 272, img.Bounds().Dx())
+	assert.Equal(t, 272, img.Bounds().Dy())
+
+	rgba := func(x int, y int) color.RGBA {
+		r, g, b, a := img.At(x, y).RGBA()
+		return color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(b >> 8), uint8(a >> 8)}
+	}
+
+	assert.Equal(t, blackColor, rgba(88+5, 184))
+	assert.Equal(t, whiteColor, rgba(184+9, 88))
+	assert.Equal(t, blackColor, rgba(184+6, 88))
+	assert.Equal(t, whiteColor, rgba(184, 88))
+	assert.Equal(t, boardColor, rgba(100, 100))
+	assert.Equal(t, blackColor, rgba(40, 100))
+}
+
+func TestRenderImage_NoLastMove(t *testing.T) {
+	g := renderTestGame(t)
+	g.Pass()
+
+	img := RenderImage(g.Board(), nil)
+	assert.Equal(t, whiteColor, img.RGBAAt(184, 88))
+
+	// a last move on an empty point, such as a captured stone, is not marked
+	_, ok := lastMoveColor(g.Board(), &Point{0, 0})
+	assert.False(t, ok)
+}
@@ -0,0 +1,111 @@
+package game
+
+import "fmt"
+
+type ScoringMethod byte
+
+const (
+	// ScoringArea counts stones on the board plus surrounded empty points.
+	ScoringArea ScoringMethod = iota
+	// ScoringTerritory counts surrounded empty points plus prisoners.
+	ScoringTerritory
+)
+
+// Ruleset decides the points on which the major rule sets for Go differ.
+type Ruleset interface {
+	Name() string
+	// SuicideAllowed reports whether a move may remove its own string of
+	// more than one stone. Single stone suicide is never allowed.
+	SuicideAllowed() bool
+	Superko() SuperkoRule
+	Scoring() ScoringMethod
+	// PassStones reports whether a player hands their opponent a prisoner
+	// every time they pass.
+	PassStones() bool
+	// HandicapCompensation reports whether White receives a point for each
+	// of Black's handicap stones.
+	HandicapCompensation() bool
+	DefaultKomi() float64
+}
+
+type rules struct {
+	name       string
+	suicide    bool
+	superko    SuperkoRule
+	scoring    ScoringMethod
+	passStones bool
+	compensate bool
+	komi       float64
+}
+
+func (r rules) Name() string               { return r.name }
+func (r rules) SuicideAllowed() bool       { return r.suicide }
+func (r rules) Superko() SuperkoRule       { return r.superko }
+func (r rules) Scoring() ScoringMethod     { return r.scoring }
+func (r rules) PassStones() bool           { return r.passStones }
+func (r rules) HandicapCompensation() bool { return r.compensate }
+func (r rules) DefaultKomi() float64       { return r.komi }
+
+var (
+	// ChineseRules compensate White for handicap stones, which would
+	// otherwise each be worth a point to Black under area scoring.
+	ChineseRules Ruleset = rules{
+		name:       "CHINESE",
+		superko:    SuperkoPositional,
+		scoring:    ScoringArea,
+		compensate: true,
+		komi:       7.5,
+	}
+	JapaneseRules Ruleset = rules{
+		name:    "JAPANESE",
+		superko: SuperkoNone,
+		scoring: ScoringTerritory,
+		komi:    6.5,
+	}
+	// AGARules counts territory with pass stones, which always gives the same
+	// result as counting area.
+	AGARules Ruleset = rules{
+		name:       "AGA",
+		superko:    SuperkoSituational,
+		scoring:    ScoringTerritory,
+		passStones: true,
+		komi:       7.5,
+	}
+	NewZealandRules Ruleset = rules{
+		name:    "NEW_ZEALAND",
+		suicide: true,
+		superko: SuperkoSituational,
+		scoring: ScoringArea,
+		komi:    7,
+	}
+	TrompTaylorRules Ruleset = rules{
+		name:    "TROMP_TAYLOR",
+		suicide: true,
+		superko: SuperkoPositional,
+		scoring: ScoringArea,
+		komi:    7.5,
+	}
+)
+
+var rulesets = []Ruleset{ChineseRules, JapaneseRules, AGARules, NewZealandRules, TrompTaylorRules}
+
+func RulesetForName(name string) (Ruleset, error) {
+	for _, r := range rulesets {
+		if r.Name() == name {
+			return r, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown ruleset %s", name)
+}
+
+// KomiFor returns the usual komi for a game under rules with handicap
+// stones: the ruleset's own komi, or half a point to break ties in handicap
+// games.
+func KomiFor(rules Ruleset, handicap int) float64 {
+	if handicap > 0 {
+		return 0.5
+	}
+
+	return rules.DefaultKomi()
+}
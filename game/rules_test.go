@@ -0,0 +1,26 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestKomiFor(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rules    Ruleset
+		handicap int
+		expected float64
+	}{
+		{"chinese", ChineseRules, 0, 7.5},
+		{"japanese", JapaneseRules, 0, 6.5},
+		{"new zealand", NewZealandRules, 0, 7},
+		{"handicap", JapaneseRules, 4, 0.5},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, KomiFor(testCase.rules, testCase.handicap))
+		})
+	}
+}
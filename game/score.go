@@ -0,0 +1,351 @@
+package game
+
+import (
+	"strconv"
+)
+
+// Score is the count of a finished position. Every tally is indexed by Color.
+type Score struct {
+	Komi      float64
+	Stones    [2]int
+	Territory [2]int
+	Prisoners [2]int
+	// Compensation is the points White receives for Black's handicap stones
+	// under rules that compensate for them.
+	Compensation int
+}
+
+// Total returns the points held by the player of color c. Komi and handicap
+// compensation are credited to White.
+func (s Score) Total(c Color) float64 {
+	rv := float64(s.Stones[c] + s.Territory[c] + s.Prisoners[c])
+	if c == White {
+		rv += s.Komi + float64(s.Compensation)
+	}
+
+	return rv
+}
+
+func (s Score) Result() Result {
+	black := s.Total(Black)
+	white := s.Total(White)
+
+	switch {
+	case black > white:
+		return Result{Winner: Black, Margin: black - white}
+	case white > black:
+		return Result{Winner: White, Margin: white - black}
+	default:
+		return Result{Draw: true}
+	}
+}
+
+// Result is the outcome of a game, formatted like the SGF RE property.
+type Result struct {
+	Winner Color
+	Draw   bool
+	Margin float64
+	// Reason is how the game was decided. Margin only applies to games that
+	// were counted.
+	Reason ResultReason
+}
+
+type ResultReason byte
+
+const (
+	ReasonScore ResultReason = iota
+	ReasonTime
+	ReasonResignation
+)
+
+func (r Result) String() string {
+	if r.Draw {
+		return "Draw"
+	}
+
+	switch r.Reason {
+	case ReasonTime:
+		return r.Winner.String() + "+T"
+	case ReasonResignation:
+		return r.Winner.String() + "+R"
+	default:
+		return r.Winner.String() + "+" + strconv.FormatFloat(r.Margin, 'f', -1, 64)
+	}
+}
+
+// TimeoutResult is the result of a game the player of color lost on time.
+func TimeoutResult(c Color) Result {
+	return Result{Winner: opp(c), Reason: ReasonTime}
+}
+
+// ResignationResult is the result of a game the player of color resigned.
+func ResignationResult(c Color) Result {
+	return Result{Winner: opp(c), Reason: ReasonResignation}
+}
+
+// AreaScore counts a position under area (Chinese) rules: each player scores
+// their living stones plus the empty regions bordered only by their stones.
+// dead lists the stones both players agreed are dead; they are removed before
+// counting.
+func AreaScore(b *Board, dead []Point, komi float64) Score {
+	rv := Score{Komi: komi}
+	b, _ = b.withoutDead(dead)
+
+	for _, n := range b.board {
+		switch n {
+		case black:
+			rv.Stones[Black] += 1
+		case white:
+			rv.Stones[White] += 1
+		}
+	}
+
+	for _, r := range b.emptyRegions() {
+		if owner, ok := r.owner(); ok {
+			rv.Territory[owner] += len(r.points)
+		}
+	}
+
+	return rv
+}
+
+// TerritoryScore counts a position under territory (Japanese) rules: each
+// player scores the empty points they surround plus their prisoners, which
+// are the stones they captured during play (indexed by the capturing Color)
+// and the opponent's dead stones. Regions that are the eyes of groups in
+// seki are not territory.
+func TerritoryScore(b *Board, dead []Point, captures [2]int, komi float64) Score {
+	rv := Score{Komi: komi}
+	b, removed := b.withoutDead(dead)
+	rv.Prisoners[Black] = captures[Black] + removed[White]
+	rv.Prisoners[White] = captures[White] + removed[Black]
+
+	seki := b.sekiStones()
+	for _, r := range b.emptyRegions() {
+		owner, ok := r.owner()
+		if !ok || r.bordersAny(b, seki) {
+			continue
+		}
+
+		rv.Territory[owner] += len(r.points)
+	}
+
+	return rv
+}
+
+// Score counts the current position with the scoring method of the game's
+// rules, compensating White for handicap stones if the rules call for it.
+func (g *Game) Score(dead []Point, komi float64) Score {
+	var rv Score
+	if g.rules.Scoring() == ScoringTerritory {
+		rv = g.TerritoryScore(dead, komi)
+	} else {
+		rv = g.AreaScore(dead, komi)
+	}
+
+	if g.rules.HandicapCompensation() {
+		rv.Compensation = g.handicap
+	}
+
+	return rv
+}
+
+// AreaScore counts the current position under area rules.
+func (g *Game) AreaScore(dead []Point, komi float64) Score {
+	return AreaScore(g.board, dead, komi)
+}
+
+// TerritoryScore counts the current position under territory rules, using
+// the stones captured so far as prisoners.
+func (g *Game) TerritoryScore(dead []Point, komi float64) Score {
+	var captures [2]int
+	copy(captures[:], g.captures)
+	return TerritoryScore(g.board, dead, captures, komi)
+}
+
+// withoutDead returns a copy of b with the dead stones taken off, and how
+// many stones of each color were removed.
+func (b *Board) withoutDead(dead []Point) (*Board, [2]int) {
+	var removed [2]int
+	rv := b.clone()
+
+	for _, p := range dead {
+		switch rv.GetNode(p.X, p.Y) {
+		case black:
+			removed[Black] += 1
+		case white:
+			removed[White] += 1
+		default:
+			continue
+		}
+
+		rv.SetNode(p.X, p.Y, empty)
+	}
+
+	return rv, removed
+}
+
+// sekiStones finds the stones of groups living in seki. A seki point is a
+// neutral point that neither player can fill without putting their own stones
+// in atari. A group is in seki when it touches a seki point and has at most
+// one liberty, such as a single eye, that is not a seki point.
+func (b *Board) sekiStones() map[int]bool {
+	points := make(map[int]bool)
+	for _, r := range b.emptyRegions() {
+		if _, ok := r.owner(); ok {
+			continue
+		}
+
+		for _, idx := range r.points {
+			x, y := b.coord(idx)
+			if b.isSelfAtari(x, y, black) && b.isSelfAtari(x, y, white) {
+				points[idx] = true
+			}
+		}
+	}
+
+	rv := make(map[int]bool)
+	for idx := range points {
+		x, y := b.coord(idx)
+		for _, n := range [][2]int{{x, y + 1}, {x, y - 1}, {x + 1, y}, {x - 1, y}} {
+			color := b.GetNode(n[0], n[1])
+			if (color != black && color != white) || rv[b.idx(n[0], n[1])] {
+				continue
+			}
+
+			str := b.findString(b.idx(n[0], n[1]))
+			other := 0
+			for _, lib := range b.liberties(str) {
+				if !points[lib] {
+					other += 1
+				}
+			}
+
+			if other <= 1 {
+				for _, stone := range str {
+					rv[stone] = true
+				}
+			}
+		}
+	}
+
+	return rv
+}
+
+// isSelfAtari reports whether a stone of color played at the empty point x, y
+// would be left with at most one liberty without capturing anything.
+func (b *Board) isSelfAtari(x int, y int, color node) bool {
+	tmp := b.clone()
+	tmp.SetNode(x, y, color)
+
+	_, neighbors := tmp.GetStringAndNeighbors(x, y)
+	for _, str := range neighbors {
+		if tmp.StringColor(str) != color && tmp.CountLiberties(str) == 0 {
+			return false
+		}
+	}
+
+	str, _ := tmp.GetString(x, y)
+	return tmp.CountLiberties(str) <= 1
+}
+
+func (b *Board) clone() *Board {
+	board := make([]node, len(b.board))
+	copy(board, b.board)
+	return &Board{
+		size:    b.size,
+		board:   board,
+		chains:  b.cloneChains(),
+		hash:    b.hash,
+		zobrist: b.zobrist,
+	}
+}
+
+// region is a maximal connected set of empty points along with the colors
+// of the stones around it.
+type region struct {
+	points       []int
+	bordersBlack bool
+	bordersWhite bool
+}
+
+// owner returns the color that surrounds the region, if only one does.
+func (r region) owner() (Color, bool) {
+	if r.bordersBlack && !r.bordersWhite {
+		return Black, true
+	}
+
+	if r.bordersWhite && !r.bordersBlack {
+		return White, true
+	}
+
+	return 0, false
+}
+
+// bordersAny reports whether any stone adjacent to the region is in stones.
+func (r region) bordersAny(b *Board, stones map[int]bool) bool {
+	if len(stones) == 0 {
+		return false
+	}
+
+	for _, idx := range r.points {
+		x, y := b.coord(idx)
+		for _, n := range [][2]int{{x, y + 1}, {x, y - 1}, {x + 1, y}, {x - 1, y}} {
+			if b.GetNode(n[0], n[1]) == edge {
+				continue
+			}
+
+			if stones[b.idx(n[0], n[1])] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (b *Board) emptyRegions() []region {
+	rv := make([]region, 0)
+	seen := make([]bool, len(b.board))
+
+	for idx, n := range b.board {
+		if n != empty || seen[idx] {
+			continue
+		}
+
+		var r region
+		stack := []int{idx}
+		seen[idx] = true
+
+		visit := func(x int, y int) {
+			switch b.GetNode(x, y) {
+			case empty:
+				i := b.idx(x, y)
+				if !seen[i] {
+					seen[i] = true
+					stack = append(stack, i)
+				}
+			case black:
+				r.bordersBlack = true
+			case white:
+				r.bordersWhite = true
+			}
+		}
+
+		for len(stack) > 0 {
+			i := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			r.points = append(r.points, i)
+
+			x, y := b.coord(i)
+			visit(x, y+1)
+			visit(x, y-1)
+			visit(x+1, y)
+			visit(x-1, y)
+		}
+
+		rv = append(rv, r)
+	}
+
+	return rv
+}
@@ -0,0 +1,172 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestAreaScore(t *testing.T) {
+	testCases := []struct {
+		name      string
+		setup     []pointcolor
+		komi      float64
+		stones    [2]int
+		territory [2]int
+		result    string
+	}{
+		{
+			"empty board",
+			[]pointcolor{},
+			7.5,
+			[2]int{0, 0},
+			[2]int{0, 0},
+			"W+7.5",
+		},
+		{
+			"single stone owns the board",
+			[]pointcolor{{2, 2, black}},
+			0,
+			[2]int{0, 1},
+			[2]int{0, 24},
+			"B+25",
+		},
+		{
+			"split board",
+			[]pointcolor{
+				{2, 0, black}, {2, 1, black}, {2, 2, black}, {2, 3, black}, {2, 4, black},
+				{3, 0, white}, {3, 1, white}, {3, 2, white}, {3, 3, white}, {3, 4, white},
+			},
+			0.5,
+			[2]int{5, 5},
+			[2]int{5, 10},
+			"B+4.5",
+		},
+		{
+			"dame is neutral",
+			[]pointcolor{
+				{1, 0, black}, {1, 1, black}, {1, 2, black}, {1, 3, black}, {1, 4, black},
+				{3, 0, white}, {3, 1, white}, {3, 2, white}, {3, 3, white}, {3, 4, white},
+			},
+			0,
+			[2]int{5, 5},
+			[2]int{5, 5},
+			"Draw",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			board := NewBoard(5)
+			for _, stone := range testCase.setup {
+				board.SetNode(stone.x, stone.y, stone.color)
+			}
+
+			score := AreaScore(board, nil, testCase.komi)
+			assert.Equal(t, testCase.stones, score.Stones)
+			assert.Equal(t, testCase.territory, score.Territory)
+			assert.Equal(t, testCase.result, score.Result().String())
+		})
+	}
+}
+
+func TestTerritoryScore(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rows      []string
+		dead      []Point
+		captures  [2]int
+		komi      float64
+		territory [2]int
+		prisoners [2]int
+		result    string
+	}{
+		{
+			"prisoners and dead stones",
+			[]string{
+				". . B W .",
+				". . B W .",
+				". . B W .",
+				". . B W .",
+				"W . B W .",
+			},
+			[]Point{{0, 0}},
+			[2]int{1, 2},
+			6.5,
+			[2]int{5, 10},
+			[2]int{1, 3},
+			"B+0.5",
+		},
+		{
+			"seki eyes are not territory",
+			[]string{
+				". . W B . .",
+				". . W B . .",
+				". . W B . .",
+				"W W W B B B",
+				"B B B W W W",
+				". B . W . W",
+			},
+			nil,
+			[2]int{0, 0},
+			0.5,
+			[2]int{6, 6},
+			[2]int{0, 0},
+			"W+0.5",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			board := boardFromRows(testCase.rows...)
+			score := TerritoryScore(board, testCase.dead, testCase.captures, testCase.komi)
+			assert.Equal(t, [2]int{0, 0}, score.Stones)
+			assert.Equal(t, testCase.territory, score.Territory)
+			assert.Equal(t, testCase.prisoners, score.Prisoners)
+			assert.Equal(t, testCase.result, score.Result().String())
+		})
+	}
+}
+
+func TestAreaScore_Dead(t *testing.T) {
+	board := boardFromRows(
+		". . B W .",
+		". . B W .",
+		". . B W .",
+		". . B W .",
+		"W . B W .",
+	)
+
+	score := AreaScore(board, []Point{{0, 0}}, 7.5)
+	assert.Equal(t, [2]int{5, 5}, score.Stones)
+	assert.Equal(t, [2]int{5, 10}, score.Territory)
+	assert.Equal(t, "W+2.5", score.Result().String())
+	assert.Equal(t, white, board.GetNode(0, 0))
+}
+
+// boardFromRows builds a board from rows of B, W and . separated by spaces,
+// listed from the top of the board down.
+func boardFromRows(rows ...string) *Board {
+	board := NewBoard(len(rows))
+	for i, row := range rows {
+		y := len(rows) - 1 - i
+		for x, c := range strings.Fields(row) {
+			switch c {
+			case "B":
+				board.SetNode(x, y, black)
+			case "W":
+				board.SetNode(x, y, white)
+			}
+		}
+	}
+
+	return board
+}
+
+func TestResult_String(t *testing.T) {
+	assert.Equal(t, "B+3.5", Result{Winner: Black, Margin: 3.5}.String())
+	assert.Equal(t, "Draw", Result{Draw: true}.String())
+	assert.Equal(t, "W+T", TimeoutResult(Black).String())
+	assert.Equal(t, "B+T", TimeoutResult(White).String())
+	assert.Equal(t, "B+R", ResignationResult(White).String())
+}
@@ -0,0 +1,115 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Move is a single entry in a game record: a stone or a pass.
+type Move struct {
+	Color Color
+	Pass  bool
+	Point Point
+}
+
+// Record is a game as it is exchanged with other Go software: the moves that
+// were played plus the information about the game that SGF files carry.
+type Record struct {
+	Size           int
+	Komi           float64
+	Handicap       int
+	HandicapStones []Point
+	Rules          Ruleset
+	Black          string
+	White          string
+	Result         string
+	Started        time.Time
+	Finished       time.Time
+	Moves          []Move
+}
+
+var sgfRulesetNames = map[string]string{
+	ChineseRules.Name():     "Chinese",
+	JapaneseRules.Name():    "Japanese",
+	AGARules.Name():         "AGA",
+	NewZealandRules.Name():  "NZ",
+	TrompTaylorRules.Name(): "Tromp-Taylor",
+}
+
+// WriteSGF writes rec to w as an SGF FF[4] file.
+func WriteSGF(w io.Writer, rec *Record) error {
+	var sb strings.Builder
+
+	sb.WriteString("(;FF[4]GM[1]CA[UTF-8]AP[tengen.io]")
+	fmt.Fprintf(&sb, "SZ[%d]", rec.Size)
+	fmt.Fprintf(&sb, "KM[%s]", strconv.FormatFloat(rec.Komi, 'f', -1, 64))
+	if rec.Handicap > 0 {
+		fmt.Fprintf(&sb, "HA[%d]", rec.Handicap)
+	}
+	if len(rec.HandicapStones) > 0 {
+		sb.WriteString("AB")
+		for _, p := range rec.HandicapStones {
+			sb.WriteString("[" + p.Format(NotationSGF, rec.Size) + "]")
+		}
+	}
+	if rec.Rules != nil {
+		writeSGFProperty(&sb, "RU", sgfRulesetNames[rec.Rules.Name()])
+	}
+	writeSGFProperty(&sb, "PB", rec.Black)
+	writeSGFProperty(&sb, "PW", rec.White)
+	if date := sgfDate(rec.Started, rec.Finished); date != "" {
+		writeSGFProperty(&sb, "DT", date)
+	}
+	writeSGFProperty(&sb, "RE", rec.Result)
+
+	for _, move := range rec.Moves {
+		sb.WriteString(";")
+		sb.WriteString(move.Color.String())
+		if move.Pass {
+			sb.WriteString("[]")
+		} else {
+			sb.WriteString("[" + move.Point.Format(NotationSGF, rec.Size) + "]")
+		}
+	}
+
+	sb.WriteString(")\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeSGFProperty(sb *strings.Builder, ident string, value string) {
+	if value == "" {
+		return
+	}
+
+	sb.WriteString(ident + "[" + escapeSGF(value) + "]")
+}
+
+// escapeSGF escapes the characters that would otherwise end or be swallowed
+// by an SGF text value.
+func escapeSGF(s string) string {
+	s = strings.Replace(s, "\\", "\\\\", -1)
+	return strings.Replace(s, "]", "\\]", -1)
+}
+
+// sgfDate formats the days a game was played on as an SGF DT value.
+func sgfDate(started time.Time, finished time.Time) string {
+	if started.IsZero() {
+		return ""
+	}
+
+	start := started.UTC().Format("2006-01-02")
+	if finished.IsZero() {
+		return start
+	}
+
+	end := finished.UTC().Format("2006-01-02")
+	if end == start {
+		return start
+	}
+
+	return start + "," + end
+}
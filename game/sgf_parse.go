@@ -0,0 +1,420 @@
+package game
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SGFNode is a node of an SGF game tree. The first child continues the main
+// line and any further children are variations.
+type SGFNode struct {
+	Properties map[string][]string
+	Children   []*SGFNode
+}
+
+// Property returns the first value of the property ident, or "" if the node
+// does not have it.
+func (n *SGFNode) Property(ident string) string {
+	values := n.Properties[ident]
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// ParseSGF parses an SGF collection and returns the root node of each game
+// tree in it. Text values are returned unescaped.
+func ParseSGF(s string) ([]*SGFNode, error) {
+	p := sgfParser{s: s}
+
+	rv := make([]*SGFNode, 0)
+	for {
+		p.skipSpace()
+		if p.done() {
+			break
+		}
+
+		root, err := p.gameTree()
+		if err != nil {
+			return nil, err
+		}
+
+		rv = append(rv, root)
+	}
+
+	if len(rv) == 0 {
+		return nil, p.errorf("no game tree")
+	}
+
+	return rv, nil
+}
+
+type sgfParser struct {
+	s   string
+	pos int
+}
+
+func (p *sgfParser) done() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *sgfParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+
+	return p.s[p.pos]
+}
+
+func (p *sgfParser) skipSpace() {
+	for !p.done() && strings.IndexByte(" \t\r\n", p.peek()) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *sgfParser) expect(c byte) error {
+	p.skipSpace()
+	if p.peek() != c {
+		return p.errorf("expected %q", c)
+	}
+
+	p.pos++
+	return nil
+}
+
+func (p *sgfParser) errorf(format string, args ...interface{}) error {
+	return SGFSyntaxError{Offset: p.pos, Reason: fmt.Sprintf(format, args...)}
+}
+
+// gameTree parses "(" Sequence GameTree* ")" and returns the first node of
+// the sequence.
+func (p *sgfParser) gameTree() (*SGFNode, error) {
+	err := p.expect('(')
+	if err != nil {
+		return nil, err
+	}
+
+	var root, last *SGFNode
+	for {
+		p.skipSpace()
+		if p.peek() != ';' {
+			break
+		}
+
+		node, err := p.node()
+		if err != nil {
+			return nil, err
+		}
+
+		if root == nil {
+			root = node
+		} else {
+			last.Children = append(last.Children, node)
+		}
+		last = node
+	}
+
+	if root == nil {
+		return nil, p.errorf("game tree without nodes")
+	}
+
+	for {
+		p.skipSpace()
+		if p.peek() != '(' {
+			break
+		}
+
+		variation, err := p.gameTree()
+		if err != nil {
+			return nil, err
+		}
+
+		last.Children = append(last.Children, variation)
+	}
+
+	err = p.expect(')')
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// node parses ";" Property*.
+func (p *sgfParser) node() (*SGFNode, error) {
+	p.pos++
+	rv := &SGFNode{Properties: make(map[string][]string)}
+
+	for {
+		p.skipSpace()
+		c := p.peek()
+		if !isUpper(c) && !isLower(c) {
+			return rv, nil
+		}
+
+		// FF[3] allowed lower case letters in identifiers, which are ignored.
+		var ident strings.Builder
+		for isUpper(p.peek()) || isLower(p.peek()) {
+			if isUpper(p.peek()) {
+				ident.WriteByte(p.peek())
+			}
+			p.pos++
+		}
+
+		if ident.Len() == 0 {
+			return nil, p.errorf("property identifier without upper case letters")
+		}
+
+		p.skipSpace()
+		if p.peek() != '[' {
+			return nil, p.errorf("property %s without a value", ident.String())
+		}
+
+		for {
+			p.skipSpace()
+			if p.peek() != '[' {
+				break
+			}
+
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+
+			rv.Properties[ident.String()] = append(rv.Properties[ident.String()], value)
+		}
+	}
+}
+
+// value parses "[" CValueType "]", resolving escapes. A backslash makes the
+// next character literal, and an escaped line break is removed.
+func (p *sgfParser) value() (string, error) {
+	p.pos++
+
+	var sb strings.Builder
+	for !p.done() {
+		c := p.peek()
+		p.pos++
+
+		switch c {
+		case ']':
+			return sb.String(), nil
+		case '\\':
+			if p.done() {
+				break
+			}
+
+			escaped := p.peek()
+			p.pos++
+			if escaped == '\n' || escaped == '\r' {
+				// a soft line break may be written as \r\n or \n\r
+				if next := p.peek(); (next == '\n' || next == '\r') && next != escaped {
+					p.pos++
+				}
+				continue
+			}
+
+			sb.WriteByte(escaped)
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", p.errorf("unterminated property value")
+}
+
+func isUpper(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+func isLower(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// ReadSGF reads the first game of an SGF collection and follows its main line
+// into a record. Variations are ignored.
+func ReadSGF(r io.Reader) (*Record, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	roots, err := ParseSGF(string(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return recordFromSGF(roots[0])
+}
+
+func recordFromSGF(root *SGFNode) (*Record, error) {
+	if gm := root.Property("GM"); gm != "" && gm != "1" {
+		return nil, fmt.Errorf("sgf: game %s is not Go", gm)
+	}
+
+	rv := &Record{
+		Size:   19,
+		Black:  root.Property("PB"),
+		White:  root.Property("PW"),
+		Result: root.Property("RE"),
+		Moves:  make([]Move, 0),
+	}
+
+	var err error
+	if sz := root.Property("SZ"); sz != "" {
+		rv.Size, err = strconv.Atoi(sz)
+		if err != nil || rv.Size < 1 || rv.Size > MaxBoardSize {
+			return nil, fmt.Errorf("sgf: unsupported board size %q", sz)
+		}
+	}
+
+	if km := root.Property("KM"); km != "" {
+		rv.Komi, err = strconv.ParseFloat(km, 64)
+		if err != nil {
+			return nil, fmt.Errorf("sgf: invalid komi %q", km)
+		}
+	}
+
+	if ha := root.Property("HA"); ha != "" {
+		rv.Handicap, err = strconv.Atoi(ha)
+		if err != nil {
+			return nil, fmt.Errorf("sgf: invalid handicap %q", ha)
+		}
+	}
+
+	if ru := root.Property("RU"); ru != "" {
+		for name, sgfName := range sgfRulesetNames {
+			if strings.EqualFold(ru, sgfName) || strings.EqualFold(ru, name) {
+				rv.Rules, _ = RulesetForName(name)
+			}
+		}
+	}
+
+	rv.Started, rv.Finished = parseSGFDate(root.Property("DT"))
+
+	// Black stones added in the root node are handicap stones. Any other
+	// setup cannot be replayed as moves.
+	for _, value := range root.Properties["AB"] {
+		move, err := sgfMove(Black, value, rv.Size)
+		if err != nil || move.Pass {
+			return nil, fmt.Errorf("sgf: invalid handicap stone %q", value)
+		}
+
+		rv.HandicapStones = append(rv.HandicapStones, move.Point)
+	}
+
+	for node := root; node != nil; {
+		for _, setup := range []string{"AB", "AW", "AE"} {
+			if _, ok := node.Properties[setup]; ok && (node != root || setup != "AB") {
+				return nil, fmt.Errorf("sgf: setup stones are not supported")
+			}
+		}
+
+		for _, color := range []Color{Black, White} {
+			values, ok := node.Properties[color.String()]
+			if !ok {
+				continue
+			}
+
+			move, err := sgfMove(color, values[0], rv.Size)
+			if err != nil {
+				return nil, err
+			}
+
+			rv.Moves = append(rv.Moves, move)
+		}
+
+		if len(node.Children) == 0 {
+			break
+		}
+		node = node.Children[0]
+	}
+
+	return rv, nil
+}
+
+func sgfMove(color Color, value string, size int) (Move, error) {
+	if value == "" || (value == "tt" && size <= 19) {
+		return Move{Color: color, Pass: true}, nil
+	}
+
+	p, err := ParsePointAs(value, NotationSGF, size)
+	if err != nil {
+		return Move{}, fmt.Errorf("sgf: invalid point %q", value)
+	}
+
+	return Move{Color: color, Point: p}, nil
+}
+
+// parseSGFDate returns the first and last full dates of an SGF DT value.
+// Shortened dates that only give a month or day are skipped.
+func parseSGFDate(dt string) (time.Time, time.Time) {
+	var first, last time.Time
+	for _, part := range strings.Split(dt, ",") {
+		t, err := time.Parse("2006-01-02", strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		if first.IsZero() {
+			first = t
+		}
+		last = t
+	}
+
+	if last.Equal(first) {
+		last = time.Time{}
+	}
+
+	return first, last
+}
+
+// Replay plays the moves of the record on a new game, rejecting records that
+// contain illegal moves or moves out of turn. Records without known rules are
+// replayed under Chinese rules.
+func (rec *Record) Replay() (*Game, error) {
+	rules := rec.Rules
+	if rules == nil {
+		rules = ChineseRules
+	}
+
+	rv := NewGameWithRules(rec.Size, rules)
+	if len(rec.HandicapStones) > 0 {
+		err := rv.PlaceHandicap(rec.HandicapStones)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, move := range rec.Moves {
+		if move.Color != rv.CurrentColor() {
+			return nil, fmt.Errorf("move %d: %s played out of turn", i+1, move.Color)
+		}
+
+		if move.Pass {
+			rv.Pass()
+			continue
+		}
+
+		_, err := rv.PlayMove(move.Point.X, move.Point.Y)
+		if err != nil {
+			return nil, fmt.Errorf("move %d: %v", i+1, err)
+		}
+	}
+
+	return rv, nil
+}
+
+type SGFSyntaxError struct {
+	Offset int
+	Reason string
+}
+
+func (e SGFSyntaxError) Error() string {
+	return fmt.Sprintf("sgf: %s at offset %d", e.Reason, e.Offset)
+}
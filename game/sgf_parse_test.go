@@ -0,0 +1,160 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSGF(t *testing.T) {
+	roots, err := ParseSGF("(;GM[1]C[a \\] b\\\\ c\\\nd]\n;B[aa](;W[bb];B[cc])(;W[dd]))(;SZ[9]AB[aa][bb])")
+	assert.NoError(t, err)
+	assert.Len(t, roots, 2)
+
+	root := roots[0]
+	assert.Equal(t, "a ] b\\ cd", root.Property("C"))
+	assert.Len(t, root.Children, 1)
+
+	move := root.Children[0]
+	assert.Equal(t, "aa", move.Property("B"))
+	assert.Len(t, move.Children, 2)
+	assert.Equal(t, "bb", move.Children[0].Property("W"))
+	assert.Equal(t, "cc", move.Children[0].Children[0].Property("B"))
+	assert.Equal(t, "dd", move.Children[1].Property("W"))
+
+	assert.Equal(t, []string{"aa", "bb"}, roots[1].Properties["AB"])
+}
+
+func TestParseSGFErrors(t *testing.T) {
+	testCases := []struct {
+		name string
+		sgf  string
+	}{
+		{"empty", ""},
+		{"no nodes", "()"},
+		{"unterminated tree", "(;B[aa]"},
+		{"unterminated value", "(;C[abc)"},
+		{"missing value", "(;B;W[aa])"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ParseSGF(testCase.sgf)
+			assert.IsType(t, SGFSyntaxError{}, err)
+		})
+	}
+}
+
+func TestReadSGF(t *testing.T) {
+	rec, err := ReadSGF(strings.NewReader("(;FF[4]GM[1]SZ[9]KM[6.5]RU[Japanese]PB[eac]PW[shig]RE[B+R]DT[2019-03-01,2019-03-02]\n;B[ee](;W[cc];B[tt];W[])(;W[gg]))"))
+	assert.NoError(t, err)
+	assert.Equal(t, 9, rec.Size)
+	assert.Equal(t, 6.5, rec.Komi)
+	assert.Equal(t, JapaneseRules, rec.Rules)
+	assert.Equal(t, "eac", rec.Black)
+	assert.Equal(t, "shig", rec.White)
+	assert.Equal(t, "B+R", rec.Result)
+	assert.Equal(t, time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), rec.Started)
+	assert.Equal(t, time.Date(2019, 3, 2, 0, 0, 0, 0, time.UTC), rec.Finished)
+	assert.Equal(t, []Move{
+		{Color: Black, Point: Point{4, 4}},
+		{Color: White, Point: Point{2, 6}},
+		{Color: Black, Pass: true},
+		{Color: White, Pass: true},
+	}, rec.Moves)
+
+	_, err = ReadSGF(strings.NewReader("(;SZ[26])"))
+	assert.Error(t, err)
+
+	rec, err = ReadSGF(strings.NewReader("(;SZ[25])"))
+	assert.NoError(t, err)
+	assert.Equal(t, MaxBoardSize, rec.Size)
+}
+
+func TestReadSGFRoundTrip(t *testing.T) {
+	rec := &Record{
+		Size:           13,
+		Komi:           0.5,
+		Handicap:       2,
+		HandicapStones: []Point{{3, 3}, {9, 9}},
+		Rules:          ChineseRules,
+		Black:          "a]b",
+		White:          "c\\d",
+		Result:         "W+0.5",
+		Moves: []Move{
+			{Color: White, Point: Point{0, 0}},
+			{Color: Black, Point: Point{12, 12}},
+			{Color: White, Pass: true},
+		},
+	}
+
+	var sb strings.Builder
+	assert.NoError(t, WriteSGF(&sb, rec))
+
+	read, err := ReadSGF(strings.NewReader(sb.String()))
+	assert.NoError(t, err)
+	assert.Equal(t, rec, read)
+}
+
+func TestRecordReplay(t *testing.T) {
+	testCases := []struct {
+		name  string
+		moves []Move
+		err   bool
+	}{
+		{
+			"legal",
+			[]Move{{Color: Black, Point: Point{0, 0}}, {Color: White, Pass: true}, {Color: Black, Point: Point{1, 0}}},
+			false,
+		},
+		{
+			"occupied",
+			[]Move{{Color: Black, Point: Point{0, 0}}, {Color: White, Point: Point{0, 0}}},
+			true,
+		},
+		{
+			"handicap",
+			[]Move{{Color: White, Point: Point{0, 0}}, {Color: Black, Point: Point{1, 0}}},
+			false,
+		},
+		{
+			"out of turn",
+			[]Move{{Color: Black, Point: Point{0, 0}}, {Color: Black, Point: Point{1, 0}}},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rec := &Record{Size: 9, Moves: testCase.moves}
+			if testCase.moves[0].Color == White {
+				rec.Handicap = 2
+				rec.HandicapStones = []Point{{2, 2}, {6, 6}}
+			}
+
+			g, err := rec.Replay()
+			if testCase.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(testCase.moves), g.MoveNumber())
+		})
+	}
+}
+
+func TestReadSGFHandicap(t *testing.T) {
+	rec, err := ReadSGF(strings.NewReader("(;SZ[9]HA[2]AB[cg][gc];W[ee])"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rec.Handicap)
+	assert.Equal(t, []Point{{2, 2}, {6, 6}}, rec.HandicapStones)
+
+	g, err := rec.Replay()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, g.Handicap())
+
+	_, err = ReadSGF(strings.NewReader("(;SZ[9];B[ee]AW[aa])"))
+	assert.Error(t, err)
+}
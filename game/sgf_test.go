@@ -0,0 +1,31 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteSGF(t *testing.T) {
+	rec := &Record{
+		Size:     19,
+		Komi:     6.5,
+		Rules:    JapaneseRules,
+		Black:    "eac",
+		White:    "sh]ig\\",
+		Result:   "W+R",
+		Started:  time.Date(2019, 3, 1, 23, 0, 0, 0, time.UTC),
+		Finished: time.Date(2019, 3, 2, 1, 0, 0, 0, time.UTC),
+		Moves: []Move{
+			{Color: Black, Point: Point{3, 15}},
+			{Color: White, Point: Point{15, 3}},
+			{Color: Black, Pass: true},
+		},
+	}
+
+	var sb strings.Builder
+	err := WriteSGF(&sb, rec)
+	assert.NoError(t, err)
+	assert.Equal(t, "(;FF[4]GM[1]CA[UTF-8]AP[tengen.io]SZ[19]KM[6.5]RU[Japanese]PB[eac]PW[sh\\]ig\\\\]DT[2019-03-01,2019-03-02]RE[W+R];B[dd];W[pp];B[])\n", sb.String())
+}
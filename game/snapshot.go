@@ -0,0 +1,168 @@
+package game
+
+import "encoding/binary"
+
+// snapshotVersion is written at the start of every encoded board and game so
+// the format can change without misreading stored snapshots.
+const snapshotVersion = 1
+
+// MarshalBinary encodes the stones on the board: its size followed by two bits
+// per point. A 19x19 board takes 93 bytes.
+func (b *Board) MarshalBinary() ([]byte, error) {
+	return b.appendBinary(make([]byte, 0, 2+(len(b.board)+3)/4)), nil
+}
+
+func (b *Board) appendBinary(buf []byte) []byte {
+	buf = append(buf, snapshotVersion)
+	buf = binary.AppendUvarint(buf, uint64(b.size))
+
+	packed := make([]byte, (len(b.board)+3)/4)
+	for idx, n := range b.board {
+		packed[idx/4] |= byte(n) << uint(2*(idx%4))
+	}
+
+	return append(buf, packed...)
+}
+
+// UnmarshalBinary replaces the board with one encoded by MarshalBinary.
+func (b *Board) UnmarshalBinary(data []byte) error {
+	_, err := b.readBinary(data)
+	return err
+}
+
+func (b *Board) readBinary(data []byte) (int, error) {
+	if len(data) == 0 || data[0] != snapshotVersion {
+		return 0, SnapshotError{"unknown snapshot version"}
+	}
+
+	size, n := binary.Uvarint(data[1:])
+	if n <= 0 || size == 0 || size > 52 {
+		return 0, SnapshotError{"invalid board size"}
+	}
+
+	offset := 1 + n
+	points := int(size * size)
+	end := offset + (points+3)/4
+	if len(data) < end {
+		return 0, SnapshotError{"board is truncated"}
+	}
+
+	rv := NewBoard(int(size))
+	for idx := 0; idx < points; idx++ {
+		value := node(data[offset+idx/4]>>uint(2*(idx%4))) & 3
+		if value == edge {
+			return 0, SnapshotError{"invalid point"}
+		}
+
+		if value != empty {
+			x, y := rv.coord(idx)
+			rv.SetNode(x, y, value)
+		}
+	}
+
+	*b = *rv
+	return end, nil
+}
+
+// MarshalBinary encodes everything needed to carry on playing the game: the
+// board, the player to move, the move number, captures, the ko point, the
+// run of passes and the position history used for superko. The ruleset is
+// not included.
+func (g *Game) MarshalBinary() ([]byte, error) {
+	buf := g.board.appendBinary(make([]byte, 0, 128+9*len(g.history)))
+	buf = append(buf, byte(g.currentColor))
+	buf = binary.AppendUvarint(buf, uint64(g.move))
+	buf = binary.AppendUvarint(buf, uint64(g.passes))
+	buf = binary.AppendUvarint(buf, uint64(g.handicap))
+	buf = binary.AppendUvarint(buf, uint64(g.captures[Black]))
+	buf = binary.AppendUvarint(buf, uint64(g.captures[White]))
+
+	// the ko point is stored one past its index so that zero means none
+	ko := 0
+	if g.ko != nil {
+		ko = g.board.idx(g.ko.X, g.ko.Y) + 1
+	}
+	buf = binary.AppendUvarint(buf, uint64(ko))
+
+	buf = binary.AppendUvarint(buf, uint64(len(g.history)))
+	for _, p := range g.history {
+		buf = binary.LittleEndian.AppendUint64(buf, p.hash)
+		buf = append(buf, byte(p.toMove))
+	}
+
+	return buf, nil
+}
+
+// RestoreGame decodes a game encoded by Game.MarshalBinary to be played on
+// under rules.
+func RestoreGame(data []byte, rules Ruleset) (*Game, error) {
+	board := &Board{}
+	offset, err := board.readBinary(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset >= len(data) || (Color(data[offset]) != Black && Color(data[offset]) != White) {
+		return nil, SnapshotError{"invalid player to move"}
+	}
+
+	g := &Game{
+		board:        board,
+		captures:     make([]int, 2),
+		currentColor: Color(data[offset]),
+		rules:        rules,
+	}
+	offset++
+
+	var values [7]int
+	for i := range values {
+		v, n := binary.Uvarint(data[offset:])
+		if n <= 0 || v > 1<<31 {
+			return nil, SnapshotError{"game is truncated"}
+		}
+
+		values[i] = int(v)
+		offset += n
+	}
+
+	g.move, g.passes, g.handicap = values[0], values[1], values[2]
+	g.captures[Black], g.captures[White] = values[3], values[4]
+
+	if ko := values[5]; ko > 0 {
+		if ko > len(board.board) {
+			return nil, SnapshotError{"invalid ko point"}
+		}
+
+		x, y := board.coord(ko - 1)
+		g.ko = &Point{x, y}
+	}
+
+	history := values[6]
+	if len(data)-offset != 9*history || history == 0 {
+		return nil, SnapshotError{"invalid history"}
+	}
+
+	g.history = make([]position, 0, history)
+	g.seen = make(map[uint64]uint8, history)
+	for i := 0; i < history; i++ {
+		g.record(position{
+			hash:   binary.LittleEndian.Uint64(data[offset:]),
+			toMove: Color(data[offset+8]),
+		})
+		offset += 9
+	}
+
+	if g.history[history-1].hash != board.Hash() {
+		return nil, SnapshotError{"history does not match the board"}
+	}
+
+	return g, nil
+}
+
+type SnapshotError struct {
+	Reason string
+}
+
+func (e SnapshotError) Error() string {
+	return "invalid snapshot: " + e.Reason
+}
@@ -0,0 +1,78 @@
+package game
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBoard_MarshalBinary(t *testing.T) {
+	board := NewBoard(19)
+	board.SetNode(3, 3, black)
+	board.SetNode(3, 4, black)
+	board.SetNode(15, 15, white)
+
+	data, err := board.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Len(t, data, 93)
+
+	var other Board
+	assert.NoError(t, other.UnmarshalBinary(data))
+	assert.Equal(t, board.board, other.board)
+	assert.Equal(t, board.Hash(), other.Hash())
+
+	string, err := other.GetString(3, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, 6, other.CountLiberties(string))
+}
+
+func TestRestoreGame(t *testing.T) {
+	moves := randomGame(9, 120, 3)
+	game := NewGameWithRules(9, JapaneseRules)
+	for _, move := range moves[:80] {
+		assert.NoError(t, play(game, move.X, move.Y))
+	}
+	game.Pass()
+
+	data, err := game.MarshalBinary()
+	assert.NoError(t, err)
+
+	restored, err := RestoreGame(data, JapaneseRules)
+	assert.NoError(t, err)
+	assert.Equal(t, game.board.board, restored.board.board)
+	assert.Equal(t, game.CurrentColor(), restored.CurrentColor())
+	assert.Equal(t, game.MoveNumber(), restored.MoveNumber())
+	assert.Equal(t, game.ConsecutivePasses(), restored.ConsecutivePasses())
+	assert.Equal(t, game.captures, restored.captures)
+	assert.Equal(t, game.ko, restored.ko)
+	assert.Equal(t, game.history, restored.history)
+}
+
+func TestRestoreGame_Ko(t *testing.T) {
+	game := NewGame(5)
+	for _, move := range []Point{{1, 0}, {2, 0}, {0, 1}, {3, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}} {
+		assert.NoError(t, play(game, move.X, move.Y))
+	}
+	assert.NotNil(t, game.ko)
+
+	data, err := game.MarshalBinary()
+	assert.NoError(t, err)
+
+	restored, err := RestoreGame(data, ChineseRules)
+	assert.NoError(t, err)
+	assert.Equal(t, game.ko, restored.ko)
+	assert.EqualError(t, play(restored, game.ko.X, game.ko.Y), KoViolationError{}.Error())
+}
+
+func TestRestoreGame_Invalid(t *testing.T) {
+	game := NewGame(9)
+	assert.NoError(t, play(game, 4, 4))
+
+	data, err := game.MarshalBinary()
+	assert.NoError(t, err)
+
+	_, err = RestoreGame(data[:len(data)-1], ChineseRules)
+	assert.IsType(t, SnapshotError{}, err)
+
+	_, err = RestoreGame(append([]byte{9}, data[1:]...), ChineseRules)
+	assert.IsType(t, SnapshotError{}, err)
+}
@@ -0,0 +1,49 @@
+package game
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// zobristKeys holds one random key per point and color for a board size. The
+// keys are seeded by the size so hashes are stable between processes.
+type zobristKeys struct {
+	black []uint64
+	white []uint64
+}
+
+var zobristMu sync.Mutex
+var zobristTables = make(map[int]*zobristKeys)
+
+func zobristFor(size int) *zobristKeys {
+	zobristMu.Lock()
+	defer zobristMu.Unlock()
+
+	if keys, ok := zobristTables[size]; ok {
+		return keys
+	}
+
+	r := rand.New(rand.NewSource(int64(size)))
+	keys := &zobristKeys{
+		black: make([]uint64, size*size),
+		white: make([]uint64, size*size),
+	}
+	for i := range keys.black {
+		keys.black[i] = r.Uint64()
+		keys.white[i] = r.Uint64()
+	}
+
+	zobristTables[size] = keys
+	return keys
+}
+
+func (z *zobristKeys) key(idx int, n node) uint64 {
+	switch n {
+	case black:
+		return z.black[idx]
+	case white:
+		return z.white[idx]
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,475 @@
+package gql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/gtp"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// botMove is the move a bot chose: a stone at point, a pass if point is nil,
+// or a resignation.
+type botMove struct {
+	point  *game.Point
+	resign bool
+}
+
+// botPlayer chooses the moves of the bots of one type.
+type botPlayer interface {
+	genMove(ctx context.Context, r *repository.Repository, bot models.Bot, g *models.Game, engine *game.Game) (botMove, error)
+}
+
+var botPlayers = map[models.BotType]botPlayer{
+	models.BotTypeGTP:     gtpPlayer{},
+	models.BotTypeBuiltin: builtinPlayer{},
+}
+
+const (
+	// maxBotBoardSize is the largest board the built-in bots take challenges
+	// on.
+	maxBotBoardSize = 13
+	// botChallengeDelay is how long a challenge stays open for people before
+	// a built-in bot takes it.
+	botChallengeDelay = 30 * time.Second
+	// maxIllegalBotMoves is how many illegal moves in a row a bot gets to
+	// choose before it passes instead.
+	maxIllegalBotMoves = 3
+)
+
+var builtinLevels = map[string]game.BotLevel{
+	"random":  game.BotLevelRandom,
+	"capture": game.BotLevelCapture,
+	"safe":    game.BotLevelSafe,
+}
+
+// builtinPlayer plays with game.Bot at the level named by the bot's command.
+type builtinPlayer struct{}
+
+func (builtinPlayer) genMove(ctx context.Context, r *repository.Repository, bot models.Bot, g *models.Game, engine *game.Game) (botMove, error) {
+	level, ok := builtinLevels[bot.Command]
+	if !ok {
+		return botMove{}, fmt.Errorf("unknown bot level %s", bot.Command)
+	}
+
+	p, ok := game.NewBot(level, time.Now().UnixNano()).GenMove(engine)
+	if !ok {
+		return botMove{}, nil
+	}
+
+	return botMove{point: &p}, nil
+}
+
+// gtpPlayer asks an external GTP engine for moves. A fresh engine is started
+// for every move and brought up to date by replaying the stored moves into
+// it, so no engine outlives a move.
+type gtpPlayer struct{}
+
+func (gtpPlayer) genMove(ctx context.Context, r *repository.Repository, bot models.Bot, g *models.Game, engine *game.Game) (botMove, error) {
+	args := strings.Fields(bot.Command)
+	if len(args) == 0 {
+		return botMove{}, fmt.Errorf("bot %s has no engine command", bot.User.Id)
+	}
+
+	e, err := gtp.Start(ctx, args[0], args[1:]...)
+	if err != nil {
+		return botMove{}, err
+	}
+	defer e.Close()
+
+	err = e.BoardSize(g.BoardSize)
+	if err == nil {
+		err = e.ClearBoard()
+	}
+	if err == nil {
+		err = e.Komi(g.Komi)
+	}
+	if err != nil {
+		return botMove{}, err
+	}
+
+	stones, err := r.GetHandicapStones(g.Id)
+	if err != nil {
+		return botMove{}, err
+	}
+
+	for _, p := range stones {
+		err = e.Play(game.Black, gtp.Move{Point: game.Point{X: p.X, Y: p.Y}})
+		if err != nil {
+			return botMove{}, err
+		}
+	}
+
+	moves, err := r.GetMovesForGame(g.Id)
+	if err != nil {
+		return botMove{}, err
+	}
+
+	// white moves first in a handicap game
+	color := game.Black
+	if len(stones) > 0 {
+		color = game.White
+	}
+
+	for _, move := range moves {
+		m := gtp.Move{Pass: true}
+		if move.Type == models.MoveTypeStone {
+			m = gtp.Move{Point: game.Point{X: *move.X, Y: *move.Y}}
+		}
+
+		err = e.Play(color, m)
+		if err != nil {
+			return botMove{}, err
+		}
+
+		if color == game.Black {
+			color = game.White
+		} else {
+			color = game.Black
+		}
+	}
+
+	m, err := e.GenMove(engine.CurrentColor(), g.BoardSize)
+	if err != nil {
+		return botMove{}, err
+	}
+
+	switch {
+	case m.Resign:
+		return botMove{resign: true}, nil
+	case m.Pass:
+		return botMove{}, nil
+	default:
+		return botMove{point: &m.Point}, nil
+	}
+}
+
+// botSeat is a bot playing in a game. Two bots can play each other, so work
+// is tracked per seat rather than per game.
+type botSeat struct {
+	gameId string
+	userId string
+}
+
+func seatOf(bg models.BotGame) botSeat {
+	return botSeat{bg.GameId, bg.Bot.User.Id}
+}
+
+// botRunner plays for bots in the games where it is their turn, through the
+// same moves, handicap placement and scoring a person would use.
+type botRunner struct {
+	repo         *repository.Repository
+	tickInterval time.Duration
+	moveTimeout  time.Duration
+	// playGame does the bot's next step in a game, which is play outside of
+	// tests
+	playGame func(bg models.BotGame) error
+
+	mu sync.Mutex
+	// playing holds the seats a bot is thinking about a move in
+	playing map[botSeat]bool
+	// illegal counts the illegal moves a bot has chosen in a row in each seat
+	illegal map[botSeat]int
+}
+
+func newBotRunner(repo *repository.Repository, tick time.Duration, moveTimeout time.Duration) *botRunner {
+	rv := &botRunner{
+		repo:         repo,
+		tickInterval: tick,
+		moveTimeout:  moveTimeout,
+		playing:      make(map[botSeat]bool),
+		illegal:      make(map[botSeat]int),
+	}
+	rv.playGame = rv.play
+
+	return rv
+}
+
+func (b *botRunner) run() {
+	for true {
+		err := b.tick()
+		if err != nil {
+			log.Printf("error finding bot games: %s", err)
+		}
+
+		time.Sleep(b.tickInterval)
+	}
+}
+
+// tick has the built-in bots take challenges nobody else has, then starts a
+// move for every bot game that is not already waiting on one. Moves are
+// chosen concurrently so one slow engine does not hold up the other games.
+func (b *botRunner) tick() error {
+	err := b.acceptChallenges(time.Now())
+	if err != nil {
+		return err
+	}
+
+	games, err := b.repo.GetBotGames()
+	if err != nil {
+		return err
+	}
+
+	b.start(games)
+	return nil
+}
+
+// start plays for each bot in games that is not still busy with its last
+// step, and returns once they have all been started.
+func (b *botRunner) start(games []models.BotGame) {
+	for _, bg := range games {
+		if !b.claim(seatOf(bg)) {
+			continue
+		}
+
+		go func(bg models.BotGame) {
+			defer b.release(seatOf(bg))
+			err := b.playGame(bg)
+			if err != nil {
+				log.Printf("bot %s in game %s: %s", bg.Bot.User.Name, bg.GameId, err)
+			}
+		}(bg)
+	}
+}
+
+// acceptChallenges seats a built-in bot in the challenges on small boards
+// that have been left open since before botChallengeDelay. Handicap games on
+// boards without star point placement are left for people.
+func (b *botRunner) acceptChallenges(now time.Time) error {
+	bots, err := b.repo.GetBots(models.BotTypeBuiltin)
+	if err != nil || len(bots) == 0 {
+		return err
+	}
+
+	challenges, err := b.repo.GetOpenChallenges(maxBotBoardSize, now.Add(-botChallengeDelay))
+	if err != nil {
+		return err
+	}
+
+	for i, g := range challenges {
+		// the bots place free handicap stones on the star points, which only
+		// some boards have
+		if g.Handicap > 0 {
+			_, err := game.HandicapPoints(g.BoardSize, g.Handicap)
+			if err != nil {
+				continue
+			}
+		}
+
+		bot := bots[i%len(bots)]
+		err = b.repo.WithTx(func(r *repository.Repository) error {
+			_, err := acceptChallenge(r, bot.User, g.Id)
+			return err
+		})
+		if err != nil {
+			log.Printf("bot %s could not accept challenge %s: %s", bot.User.Name, g.Id, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *botRunner) claim(seat botSeat) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.playing[seat] {
+		return false
+	}
+
+	b.playing[seat] = true
+	return true
+}
+
+func (b *botRunner) release(seat botSeat) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.playing, seat)
+}
+
+// illegalMove counts another illegal move chosen in the seat and reports
+// whether the bot has run out of tries.
+func (b *botRunner) illegalMove(seat botSeat) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.illegal[seat]++
+	if b.illegal[seat] < maxIllegalBotMoves {
+		return false
+	}
+
+	delete(b.illegal, seat)
+	return true
+}
+
+func (b *botRunner) legalMove(seat botSeat) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.illegal, seat)
+}
+
+// play does whatever the bot in bg has to do next: place its handicap stones,
+// accept the score, or choose and play a move.
+func (b *botRunner) play(bg models.BotGame) error {
+	g, err := b.repo.GetGameById(bg.GameId)
+	if err != nil {
+		return err
+	}
+
+	users, err := b.repo.GetUsersForGame(g.Id)
+	if err != nil {
+		return err
+	}
+
+	if len(users) < 2 {
+		return nil
+	}
+
+	user := bg.Bot.User
+	if g.State == models.GameStateScoring {
+		// bots go along with the dead stones their opponent marks
+		for _, edge := range users {
+			if edge.User.Id == user.Id && !edge.ScoreAccepted {
+				return b.repo.WithTx(func(r *repository.Repository) error {
+					_, err := acceptScore(r, user, g.Id)
+					return err
+				})
+			}
+		}
+
+		return nil
+	}
+
+	engine, err := loadGame(b.repo, g)
+	if err != nil {
+		return err
+	}
+
+	color, err := colorForUser(users, user)
+	if err != nil {
+		return err
+	}
+
+	if engine.Handicap() < g.Handicap {
+		if color != game.Black {
+			return nil
+		}
+
+		points, err := game.HandicapPoints(g.BoardSize, g.Handicap)
+		if err != nil {
+			return err
+		}
+
+		return b.repo.WithTx(func(r *repository.Repository) error {
+			_, err := placeHandicap(r, user, g.Id, fromGamePoints(points))
+			return err
+		})
+	}
+
+	if engine.CurrentColor() != color {
+		return nil
+	}
+
+	player, ok := botPlayers[bg.Bot.Type]
+	if !ok {
+		return fmt.Errorf("unknown bot type %s", bg.Bot.Type)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.moveTimeout)
+	defer cancel()
+
+	move, err := player.genMove(ctx, b.repo, bg.Bot, g, engine)
+	if err != nil {
+		return err
+	}
+
+	number := engine.MoveNumber()
+	if move.point != nil {
+		// engines can disagree with our rules, so try the move on the engine
+		// first rather than asking it again forever
+		_, err = engine.PlayMove(move.point.X, move.point.Y)
+		if err != nil {
+			reply := gtp.Move{Point: *move.point}
+			if !b.illegalMove(seatOf(bg)) {
+				return fmt.Errorf("engine replied %s, which is illegal: %s", reply, err)
+			}
+
+			log.Printf("bot %s in game %s: engine replied %s, which is illegal: %s; passing after %d tries", user.Name, g.Id, reply, err, maxIllegalBotMoves)
+			move = botMove{}
+		}
+	}
+	b.legalMove(seatOf(bg))
+
+	return b.repo.WithTx(func(r *repository.Repository) error {
+		// the game may have moved on while the bot was thinking, through a
+		// takeback, a resignation or the clock running out
+		locked, err := r.GetGameByIdForUpdate(g.Id)
+		if err != nil {
+			return err
+		}
+
+		if locked.State != models.GameStateNegotiation && locked.State != models.GameStateInProgress {
+			return nil
+		}
+
+		current, err := loadGame(r, locked)
+		if err != nil {
+			return err
+		}
+
+		if current.MoveNumber() != number {
+			return nil
+		}
+
+		switch {
+		case move.resign:
+			_, err = resign(r, user, g.Id)
+		case move.point == nil:
+			_, err = makeMove(r, user, g.Id, models.MoveTypePass, 0, 0)
+		default:
+			_, err = makeMove(r, user, g.Id, models.MoveTypeStone, move.point.X, move.point.Y)
+		}
+
+		return err
+	})
+}
+
+// StartBots runs the worker that plays for bot accounts. Only one should run
+// at a time.
+func StartBots() {
+	repo := makeRepo()
+
+	tickTimeMs, err := strconv.Atoi(os.Getenv("TENGEN_BOT_TICK_TIME_MS"))
+	if err != nil {
+		log.Fatal("Could not parse TENGEN_BOT_TICK_TIME_MS")
+	}
+
+	moveTimeoutMs, err := strconv.Atoi(os.Getenv("TENGEN_BOT_MOVE_TIMEOUT_MS"))
+	if err != nil {
+		log.Fatal("Could not parse TENGEN_BOT_MOVE_TIMEOUT_MS")
+	}
+
+	log.Printf("starting bots")
+	runner := newBotRunner(repo, time.Duration(tickTimeMs)*time.Millisecond, time.Duration(moveTimeoutMs)*time.Millisecond)
+	runner.run()
+}
+
+// CreateBot adds a bot account called name, played by the GTP engine started
+// with command.
+func CreateBot(name string, command []string) (*models.Bot, error) {
+	if len(command) == 0 {
+		return nil, errors.New("bot needs an engine command")
+	}
+
+	return makeRepo().CreateBot(name, models.BotTypeGTP, strings.Join(command, " "))
+}
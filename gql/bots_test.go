@@ -0,0 +1,58 @@
+package gql
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tengen-io/server/models"
+	"testing"
+	"time"
+)
+
+func engineSeat(gameId string, userId string, index int) models.BotGame {
+	return models.BotGame{
+		GameId: gameId,
+		Index:  index,
+		Bot: models.Bot{
+			User:    models.User{NodeFields: models.NodeFields{Id: userId}, Name: "bot " + userId},
+			Type:    models.BotTypeGTP,
+			Command: "gnugo --mode gtp",
+		},
+	}
+}
+
+func TestBotRunner_start(t *testing.T) {
+	b := newBotRunner(nil, time.Second, time.Second)
+	black, white := engineSeat("1", "10", 0), engineSeat("1", "11", 1)
+
+	thinking := make(chan struct{})
+	played := make(chan models.BotGame, 4)
+	b.playGame = func(bg models.BotGame) error {
+		if bg.Bot.User.Id == black.Bot.User.Id {
+			<-thinking
+		}
+
+		played <- bg
+		return nil
+	}
+
+	// black is still thinking about its move when white gets its turn
+	b.start([]models.BotGame{black, white})
+	assert.Equal(t, white, <-played)
+
+	for {
+		b.mu.Lock()
+		busy := b.playing[seatOf(white)]
+		b.mu.Unlock()
+		if !busy {
+			break
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	b.start([]models.BotGame{black, white})
+	assert.Equal(t, white, <-played)
+
+	close(thinking)
+	assert.Equal(t, black, <-played)
+	assert.Empty(t, played)
+}
@@ -0,0 +1,243 @@
+package gql
+
+import (
+	"errors"
+	"fmt"
+	"github.com/tengen-io/server/clock"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+	"math"
+	"time"
+)
+
+const (
+	maxBoardSize = game.MaxBoardSize
+	maxKomi      = 150
+)
+
+// gameSettings fills in the defaults for any settings left out of input and
+// checks that a game can be played with the result.
+func gameSettings(input models.GameSettingsInput) (models.GameSettings, error) {
+	rv := models.GameSettings{
+		BoardSize:         19,
+		Ruleset:           models.RulesetChinese,
+		HandicapPlacement: models.HandicapPlacementFixed,
+		UndoAllowed:       true,
+	}
+
+	if input.BoardSize != nil {
+		rv.BoardSize = *input.BoardSize
+	}
+	if input.Ruleset != nil {
+		rv.Ruleset = *input.Ruleset
+	}
+	if input.Handicap != nil {
+		rv.Handicap = *input.Handicap
+	}
+	if input.HandicapPlacement != nil {
+		rv.HandicapPlacement = *input.HandicapPlacement
+	}
+	if input.UndoAllowed != nil {
+		rv.UndoAllowed = *input.UndoAllowed
+	}
+
+	if rv.BoardSize < 2 || rv.BoardSize > maxBoardSize {
+		return rv, fmt.Errorf("board size must be between 2 and %d", maxBoardSize)
+	}
+
+	if !rv.Ruleset.IsValid() {
+		return rv, fmt.Errorf("%s is not a valid Ruleset", rv.Ruleset)
+	}
+
+	if rv.Handicap != 0 && (rv.Handicap < game.MinHandicap || rv.Handicap > game.MaxHandicap) {
+		return rv, fmt.Errorf("handicap must be 0 or between %d and %d stones", game.MinHandicap, game.MaxHandicap)
+	}
+
+	if rv.Handicap > 0 && rv.HandicapPlacement == models.HandicapPlacementFixed {
+		_, err := game.HandicapPoints(rv.BoardSize, rv.Handicap)
+		if err != nil {
+			return rv, err
+		}
+	}
+
+	rules, err := game.RulesetForName(string(rv.Ruleset))
+	if err != nil {
+		return rv, err
+	}
+
+	rv.Komi = game.KomiFor(rules, rv.Handicap)
+	if input.Komi != nil {
+		rv.Komi = *input.Komi
+	}
+
+	// komi is counted in half points so that it can break ties
+	if rv.Komi != math.Floor(rv.Komi*2)/2 || math.Abs(rv.Komi) > maxKomi {
+		return rv, fmt.Errorf("komi must be a multiple of 0.5 between -%d and %d", maxKomi, maxKomi)
+	}
+
+	if input.TimeControl != nil {
+		tc := timeControlFromInput(*input.TimeControl)
+		err = clock.TimeControlFor(tc).Validate()
+		if err != nil {
+			return rv, err
+		}
+
+		rv.TimeControl = &tc
+	}
+
+	return rv, nil
+}
+
+// createChallenge opens a game that waits for any other user to accept it.
+// The challenger takes the seat for color.
+func createChallenge(r *repository.Repository, user models.User, color models.Color, settings models.GameSettings) (*models.Game, error) {
+	g, err := r.CreateGame(models.GameTypeStandard, settings, models.GameStateNegotiation, []models.User{})
+	if err != nil {
+		return nil, err
+	}
+
+	index := 0
+	if color == models.ColorWhite {
+		index = 1
+	}
+
+	err = r.AddGamePlayer(g.Id, user, index)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.Handicap > 0 && settings.HandicapPlacement == models.HandicapPlacementFixed {
+		points, err := game.HandicapPoints(settings.BoardSize, settings.Handicap)
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.AddHandicapStones(g.Id, fromGamePoints(points))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if settings.TimeControl != nil {
+		err = r.CreateTimeControl(g.Id, *settings.TimeControl)
+		if err != nil {
+			return nil, err
+		}
+
+		err = createClocks(r, g.Id, *settings.TimeControl)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// acceptChallenge seats user opposite the challenger.
+func acceptChallenge(r *repository.Repository, user models.User, gameId string) (*models.Game, error) {
+	g, err := r.GetGameByIdForUpdate(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.Type != models.GameTypeStandard || g.State != models.GameStateNegotiation {
+		return nil, errors.New("game is not an open challenge")
+	}
+
+	users, err := r.GetUsersForGame(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) != 1 {
+		return nil, errors.New("game is not an open challenge")
+	}
+
+	if users[0].User.Id == user.Id {
+		return nil, errors.New("cannot accept your own challenge")
+	}
+
+	err = r.AddGamePlayer(g.Id, user, 1-users[0].Index)
+	if err != nil {
+		return nil, err
+	}
+
+	// With free placement the clocks start once the handicap stones are down.
+	switch {
+	case g.Handicap == 0:
+		err = startClock(r, g.Id, game.Black, time.Now().UTC())
+	case g.HandicapPlacement == models.HandicapPlacementFixed:
+		err = startClock(r, g.Id, game.White, time.Now().UTC())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// placeHandicap puts down the handicap stones Black chose in a game with
+// free placement.
+func placeHandicap(r *repository.Repository, user models.User, gameId string, points []models.Point) (*models.Game, error) {
+	g, err := r.GetGameByIdForUpdate(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.State != models.GameStateNegotiation || g.HandicapPlacement != models.HandicapPlacementFree || g.Handicap == 0 {
+		return nil, errors.New("game does not take free handicap placement")
+	}
+
+	users, err := r.GetUsersForGame(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	color, err := colorForUser(users, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if color != game.Black {
+		return nil, errors.New("only black places handicap stones")
+	}
+
+	if len(points) != g.Handicap {
+		return nil, fmt.Errorf("game has a handicap of %d stones", g.Handicap)
+	}
+
+	engine, err := loadGame(r, g)
+	if err != nil {
+		return nil, err
+	}
+
+	if engine.Handicap() > 0 {
+		return nil, errors.New("handicap stones have already been placed")
+	}
+
+	err = engine.PlaceHandicap(toGamePoints(points))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.AddHandicapStones(g.Id, points)
+	if err != nil {
+		return nil, err
+	}
+
+	err = startClock(r, g.Id, game.White, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	err = publishGameEvent(r, g.Id, models.GameEventTypeHandicap, map[string]interface{}{
+		"blackCaptures": engine.Captures(game.Black),
+		"whiteCaptures": engine.Captures(game.White),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
@@ -0,0 +1,129 @@
+package gql
+
+import (
+	"github.com/tengen-io/server/clock"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+	"time"
+)
+
+func timeControlFromInput(input models.TimeControlInput) models.TimeControl {
+	rv := models.TimeControl{System: input.System}
+	for _, field := range []struct {
+		dst *int
+		src *int
+	}{
+		{&rv.MainTime, input.MainTime},
+		{&rv.Increment, input.Increment},
+		{&rv.MaxTime, input.MaxTime},
+		{&rv.Periods, input.Periods},
+		{&rv.PeriodTime, input.PeriodTime},
+		{&rv.PeriodMoves, input.PeriodMoves},
+	} {
+		if field.src != nil {
+			*field.dst = *field.src
+		}
+	}
+
+	return rv
+}
+
+func clockIndex(c game.Color) int {
+	if c == game.Black {
+		return 0
+	}
+
+	return 1
+}
+
+// createClocks gives both players a full clock, stopped until the game
+// starts.
+func createClocks(r *repository.Repository, gameId string, tc models.TimeControl) error {
+	start := clock.TimeControlFor(tc).Start()
+	for _, index := range []int{0, 1} {
+		err := r.SaveClock(gameId, clock.ClockFor(index, start))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findClock(clocks []models.Clock, color game.Color) (models.Clock, bool) {
+	for _, c := range clocks {
+		if c.Index == clockIndex(color) {
+			return c, true
+		}
+	}
+
+	return models.Clock{}, false
+}
+
+// startClock starts the clock of the player of color at now. Untimed games
+// have no clocks and are left alone.
+func startClock(r *repository.Repository, gameId string, color game.Color, now time.Time) error {
+	clocks, err := r.GetClocks(gameId)
+	if err != nil {
+		return err
+	}
+
+	c, ok := findClock(clocks, color)
+	if !ok {
+		return nil
+	}
+
+	c.RunningSince = &now
+	return r.SaveClock(gameId, c)
+}
+
+// stopClock charges the player of color for the time since their clock was
+// started. It returns false, leaving the clock as it was, if they ran out of
+// time.
+func stopClock(r *repository.Repository, gameId string, color game.Color, now time.Time) (bool, error) {
+	tc, err := r.GetTimeControl(gameId)
+	if err != nil || tc == nil {
+		return true, err
+	}
+
+	clocks, err := r.GetClocks(gameId)
+	if err != nil {
+		return false, err
+	}
+
+	c, ok := findClock(clocks, color)
+	if !ok || c.RunningSince == nil {
+		return true, nil
+	}
+
+	state, ok := clock.TimeControlFor(*tc).Spend(clock.StateFor(c), now.Sub(*c.RunningSince))
+	if !ok {
+		return false, nil
+	}
+
+	return true, r.SaveClock(gameId, clock.ClockFor(c.Index, state))
+}
+
+// stopClocks stops the clocks of a game that has ended, without charging
+// anyone for the time since they started.
+func stopClocks(r *repository.Repository, gameId string) error {
+	clocks, err := r.GetClocks(gameId)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range clocks {
+		if c.RunningSince == nil {
+			continue
+		}
+
+		c.RunningSince = nil
+		err = r.SaveClock(gameId, c)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
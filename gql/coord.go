@@ -0,0 +1,83 @@
+package gql
+
+import (
+	"errors"
+	"fmt"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+)
+
+// pointArg reads a point given to a query or mutation, either as x and y or
+// as a vertex in any notation game.ParsePoint reads, on a board of size.
+func pointArg(size int, x *int, y *int, vertex *string) (game.Point, error) {
+	if vertex != nil {
+		if x != nil || y != nil {
+			return game.Point{}, errors.New("give either x and y or a vertex")
+		}
+
+		return game.ParsePoint(*vertex, size)
+	}
+
+	if x == nil || y == nil {
+		return game.Point{}, errors.New("point needs both x and y")
+	}
+
+	p := game.Point{X: *x, Y: *y}
+	if p.X < 0 || p.Y < 0 || p.X >= size || p.Y >= size {
+		return game.Point{}, game.CoordinateError{Value: p.Format(game.NotationNumeric, size)}
+	}
+
+	return p, nil
+}
+
+// gamePointArg reads a point given to a mutation on the game gameId.
+func gamePointArg(r *repository.Repository, gameId string, x *int, y *int, vertex *string) (game.Point, error) {
+	g, err := r.GetGameById(gameId)
+	if err != nil {
+		return game.Point{}, err
+	}
+
+	return pointArg(g.BoardSize, x, y, vertex)
+}
+
+// notations maps the notations in the schema onto the game package's.
+var notations = map[models.Notation]game.Notation{
+	models.NotationGtp:     game.NotationGTP,
+	models.NotationSgf:     game.NotationSGF,
+	models.NotationNumeric: game.NotationNumeric,
+}
+
+// vertex formats x, y in notation, GTP if it is nil. GTP gives nil for points
+// off the board. SGF counts rows from the top, so it needs boardSize.
+func vertex(x int, y int, notation *models.Notation, boardSize *int) (*string, error) {
+	n := game.NotationGTP
+	if notation != nil {
+		var ok bool
+		n, ok = notations[*notation]
+		if !ok {
+			return nil, fmt.Errorf("%s is not a valid Notation", *notation)
+		}
+	}
+
+	size := game.MaxBoardSize
+	if boardSize != nil {
+		size = *boardSize
+		if size < 1 || size > game.MaxBoardSize {
+			return nil, fmt.Errorf("board size must be between 1 and %d", game.MaxBoardSize)
+		}
+	} else if n == game.NotationSGF {
+		return nil, errors.New("SGF vertices need the boardSize")
+	}
+
+	if x < 0 || y < 0 || x >= size || y >= size {
+		if n == game.NotationGTP {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("%d,%d is not on a board of size %d", x, y, size)
+	}
+
+	rv := game.Point{X: x, Y: y}.Format(n, size)
+	return &rv, nil
+}
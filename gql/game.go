@@ -0,0 +1,345 @@
+package gql
+
+import (
+	"errors"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/pubsub"
+	"github.com/tengen-io/server/repository"
+	"math"
+	"time"
+)
+
+// snapshotInterval is how many moves apart the stored snapshots of a game's
+// position are.
+const snapshotInterval = 50
+
+// loadGame rebuilds the rules engine state for g from its latest snapshot
+// and the stored moves played after it.
+func loadGame(r *repository.Repository, g *models.Game) (*game.Game, error) {
+	return loadGameAt(r, g, math.MaxInt32)
+}
+
+// loadGameAt rebuilds the rules engine state for g as it was after the move
+// with the given number, starting from the latest snapshot before it.
+func loadGameAt(r *repository.Repository, g *models.Game, number int) (*game.Game, error) {
+	rules, err := game.RulesetForName(string(g.Ruleset))
+	if err != nil {
+		return nil, err
+	}
+
+	from, snapshot, err := r.GetLatestSnapshot(g.Id, number)
+	if err != nil {
+		return nil, err
+	}
+
+	var rv *game.Game
+	if snapshot != nil {
+		rv, err = game.RestoreGame(snapshot, rules)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rv = game.NewGameWithRules(g.BoardSize, rules)
+		if g.Handicap > 0 {
+			stones, err := r.GetHandicapStones(g.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			// with free placement the stones are missing until black places them
+			if len(stones) > 0 {
+				err = rv.PlaceHandicap(toGamePoints(stones))
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	moves, err := r.GetMovesForGameAfter(g.Id, from)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, move := range moves {
+		if move.Number > number {
+			break
+		}
+
+		switch move.Type {
+		case models.MoveTypeStone:
+			_, err = rv.PlayMove(*move.X, *move.Y)
+			if err != nil {
+				return nil, err
+			}
+		case models.MoveTypePass:
+			rv.Pass()
+		}
+	}
+
+	return rv, nil
+}
+
+// colorForUser maps a player onto a color by their index in the game: the
+// first player takes black and the second takes white.
+func colorForUser(users []models.GameUserEdge, user models.User) (game.Color, error) {
+	for _, edge := range users {
+		if edge.User.Id != user.Id {
+			continue
+		}
+
+		switch edge.Index {
+		case 0:
+			return game.Black, nil
+		case 1:
+			return game.White, nil
+		}
+	}
+
+	return 0, errors.New("user is not a player in this game")
+}
+
+// makeMove validates and records a stone or pass by user in the game with the
+// given id. x and y are ignored for passes.
+func makeMove(r *repository.Repository, user models.User, gameId string, moveType models.MoveType, x int, y int) (*models.MovePayload, error) {
+	g, err := r.GetGameByIdForUpdate(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch g.State {
+	case models.GameStateScoring:
+		return nil, errors.New("game is being scored")
+	case models.GameStateFinished:
+		return nil, errors.New("game is finished")
+	}
+
+	users, err := r.GetUsersForGame(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) < 2 {
+		return nil, errors.New("game is waiting for an opponent")
+	}
+
+	color, err := colorForUser(users, user)
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := loadGame(r, g)
+	if err != nil {
+		return nil, err
+	}
+
+	if engine.Handicap() < g.Handicap {
+		return nil, errors.New("game is waiting for black to place handicap stones")
+	}
+
+	if engine.CurrentColor() != color {
+		return nil, errors.New("it is not your turn")
+	}
+
+	now := time.Now().UTC()
+	before, err := r.GetClocks(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	inTime, err := stopClock(r, g.Id, color, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if !inTime {
+		return nil, errors.New("you have run out of time")
+	}
+
+	var move *models.Move
+	var result *game.MoveResult
+	switch moveType {
+	case models.MoveTypeStone:
+		result, err = engine.PlayMove(x, y)
+		if err != nil {
+			return nil, err
+		}
+
+		move, err = r.CreateMove(g.Id, user, engine.MoveNumber(), moveType, &x, &y)
+	case models.MoveTypePass:
+		result = engine.Pass()
+		move, err = r.CreateMove(g.Id, user, engine.MoveNumber(), moveType, nil, nil)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if move.Number%snapshotInterval == 0 {
+		snapshot, err := engine.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		err = r.SaveSnapshot(g.Id, move.Number, snapshot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if c, ok := findClock(before, color); ok {
+		err = r.SaveClockHistory(g.Id, move.Number, c)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// a takeback can only be asked for the last move
+	if g.UndoRequest != nil {
+		err = r.SetUndoRequest(g.Id, nil)
+		if err != nil {
+			return nil, err
+		}
+		g.UndoRequest = nil
+	}
+
+	// Play that resumes after a rejected score needs another two passes, so
+	// only every second consecutive pass starts scoring.
+	passes := engine.ConsecutivePasses()
+	scoring := passes > 0 && passes%2 == 0
+
+	// the clocks stay stopped while the game is scored
+	if !scoring {
+		err = startClock(r, g.Id, engine.CurrentColor(), now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clocks, err := r.GetClocks(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	eventType := models.GameEventTypeMove
+	if moveType == models.MoveTypePass {
+		eventType = models.GameEventTypePass
+	}
+
+	moveResult := toMoveResult(result)
+	err = publishGameEvent(r, g.Id, eventType, map[string]interface{}{
+		"move":          move.Id,
+		"result":        moveResult,
+		"blackCaptures": engine.Captures(game.Black),
+		"whiteCaptures": engine.Captures(game.White),
+		"clocks":        clocks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if scoring {
+		err = setGameState(r, g, engine, models.GameStateScoring)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if g.State == models.GameStateNegotiation && moveType == models.MoveTypeStone {
+		err = setGameState(r, g, engine, models.GameStateInProgress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &models.MovePayload{
+		Game:   *g,
+		Move:   *move,
+		Result: moveResult,
+	}, nil
+}
+
+// setGameState moves g to state and tells subscribers about it.
+func setGameState(r *repository.Repository, g *models.Game, engine *game.Game, state models.GameState) error {
+	err := r.UpdateGameState(g.Id, state)
+	if err != nil {
+		return err
+	}
+
+	g.State = state
+	return publishGameEvent(r, g.Id, models.GameEventTypeStateChange, map[string]interface{}{
+		"state":         g.State.String(),
+		"blackCaptures": engine.Captures(game.Black),
+		"whiteCaptures": engine.Captures(game.White),
+	})
+}
+
+// finishGame scores the final position of engine with the agreed dead
+// stones, records the result on g and tells subscribers that the game is over.
+func finishGame(r *repository.Repository, g *models.Game, engine *game.Game, dead []game.Point) error {
+	result := engine.Score(dead, g.Komi).Result().String()
+	err := r.FinishGame(g.Id, result)
+	if err != nil {
+		return err
+	}
+
+	g.State = models.GameStateFinished
+	g.Result = &result
+	return publishGameEvent(r, g.Id, models.GameEventTypeStateChange, map[string]interface{}{
+		"state":         g.State.String(),
+		"gameResult":    result,
+		"blackCaptures": engine.Captures(game.Black),
+		"whiteCaptures": engine.Captures(game.White),
+	})
+}
+
+func toMoveResult(result *game.MoveResult) models.MoveResult {
+	rv := models.MoveResult{
+		Color:    toModelColor(result.Color),
+		Captured: fromGamePoints(result.Captured),
+		Captures: models.Captures{
+			Black: result.Captures[game.Black],
+			White: result.Captures[game.White],
+		},
+		Next: toModelColor(result.Next),
+	}
+
+	if result.Point != nil {
+		rv.Point = &models.Point{X: result.Point.X, Y: result.Point.Y}
+	}
+
+	if result.Ko != nil {
+		rv.Ko = &models.Point{X: result.Ko.X, Y: result.Ko.Y}
+	}
+
+	return rv
+}
+
+func toGamePoints(points []models.Point) []game.Point {
+	rv := make([]game.Point, len(points))
+	for i, p := range points {
+		rv[i] = game.Point{X: p.X, Y: p.Y}
+	}
+
+	return rv
+}
+
+func fromGamePoints(points []game.Point) []models.Point {
+	rv := make([]models.Point, len(points))
+	for i, p := range points {
+		rv[i] = models.Point{X: p.X, Y: p.Y}
+	}
+
+	return rv
+}
+
+// publishGameEvent notifies subscribers of the game with the given id. When r
+// is inside a transaction the event is only delivered once it commits.
+func publishGameEvent(r *repository.Repository, gameId string, eventType models.GameEventType, payload map[string]interface{}) error {
+	return r.Publish(pubsub.TopicCategoryGames, pubsub.Event{
+		Subject: gameId,
+		Event:   eventType.String(),
+		Payload: payload,
+	})
+}
@@ -1,9 +1,12 @@
 package gql
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"github.com/dgrijalva/jwt-go"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
 	"golang.org/x/crypto/bcrypt"
 	"io/ioutil"
 	"net/http"
@@ -164,3 +167,93 @@ func (s *server) RegistrationHandler() http.Handler {
 		w.Write(out)
 	})
 }
+
+// GamesHandler serves downloads of individual games under /games/: the
+// record at /games/{id}.sgf, and pictures of the board at
+// /games/{id}/board.svg and /games/{id}/board.png, after the move given by the
+// move parameter or the latest one. Game records are public, so no
+// authentication is required.
+func (s *server) GamesHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		name := strings.TrimPrefix(r.URL.Path, "/games/")
+		var id, format string
+		switch {
+		case strings.HasSuffix(name, ".sgf"):
+			id, format = strings.TrimSuffix(name, ".sgf"), "sgf"
+		case strings.HasSuffix(name, "/board.svg"):
+			id, format = strings.TrimSuffix(name, "/board.svg"), "svg"
+		case strings.HasSuffix(name, "/board.png"):
+			id, format = strings.TrimSuffix(name, "/board.png"), "png"
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		if _, err := strconv.Atoi(id); err != nil {
+			http.NotFound(w, r)
+			return
+		}
+
+		g, err := s.repo.GetGameById(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		if format != "sgf" {
+			s.serveBoard(w, r, g, format)
+			return
+		}
+
+		sgf, err := gameSGF(s.repo, g)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-go-sgf; charset=utf-8")
+		w.Header().Set("Content-Disposition", "attachment; filename=\"tengen-"+id+".sgf\"")
+		w.Write([]byte(sgf))
+	})
+}
+
+// serveBoard draws the board of g as an SVG or PNG image.
+func (s *server) serveBoard(w http.ResponseWriter, r *http.Request, g *models.Game, format string) {
+	number := -1
+	if move := r.URL.Query().Get("move"); move != "" {
+		n, err := strconv.Atoi(move)
+		if err != nil || n < 0 {
+			http.Error(w, "invalid move", http.StatusBadRequest)
+			return
+		}
+
+		number = n
+	}
+
+	board, last, err := gameBoard(s.repo, g, number)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	var buf bytes.Buffer
+	if format == "svg" {
+		w.Header().Set("Content-Type", "image/svg+xml")
+		err = game.WriteSVG(&buf, board, last)
+	} else {
+		w.Header().Set("Content-Type", "image/png")
+		err = game.WritePNG(&buf, board, last)
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,104 @@
+package gql
+
+import (
+	"errors"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+)
+
+// positionCacheSize is how many positions of finished games are kept in
+// memory for reviewers scrubbing through them.
+const positionCacheSize = 4096
+
+type positionKey struct {
+	gameId string
+	move   int
+}
+
+// gamePosition replays g up to the move with the given number, where zero is
+// the position before the first move.
+func gamePosition(r *repository.Repository, g *models.Game, number int) (*models.Position, error) {
+	moves, err := r.GetMovesForGame(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if number < 0 || number > len(moves) {
+		return nil, errors.New("game has no such move")
+	}
+
+	engine, err := loadGameAt(r, g, number)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &models.Position{
+		Move:          number,
+		ToMove:        toModelColor(engine.CurrentColor()),
+		Board:         make([][]*models.Color, g.BoardSize),
+		BlackCaptures: engine.Captures(game.Black),
+		WhiteCaptures: engine.Captures(game.White),
+	}
+
+	for y := range rv.Board {
+		rv.Board[y] = make([]*models.Color, g.BoardSize)
+		for x := range rv.Board[y] {
+			if c, ok := engine.ColorAt(x, y); ok {
+				color := toModelColor(c)
+				rv.Board[y][x] = &color
+			}
+		}
+	}
+
+	if ko := engine.Ko(); ko != nil {
+		rv.Ko = &models.Point{X: ko.X, Y: ko.Y}
+	}
+
+	if number > 0 {
+		rv.LastMove = &moves[number-1]
+	}
+
+	return rv, nil
+}
+
+// gameBoard returns the board of g after the move with the given number, or
+// after the latest move if number is negative, along with the point of that
+// move if it was a stone.
+func gameBoard(r *repository.Repository, g *models.Game, number int) (*game.Board, *game.Point, error) {
+	moves, err := r.GetMovesForGame(g.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if number < 0 {
+		number = len(moves)
+	}
+
+	if number > len(moves) {
+		return nil, nil, errors.New("game has no such move")
+	}
+
+	engine, err := loadGameAt(r, g, number)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var last *game.Point
+	if number > 0 {
+		move := moves[number-1]
+		if move.Type == models.MoveTypeStone {
+			last = &game.Point{X: *move.X, Y: *move.Y}
+		}
+	}
+
+	return engine.Board(), last, nil
+}
+
+func toModelColor(c game.Color) models.Color {
+	if c == game.White {
+		return models.ColorWhite
+	}
+
+	return models.ColorBlack
+}
@@ -0,0 +1,80 @@
+package gql
+
+import (
+	"errors"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+)
+
+// playableGame rebuilds the rules engine for g if the player to move can
+// play a stone, and returns nil otherwise.
+func playableGame(r *repository.Repository, g *models.Game) (*game.Game, error) {
+	if g.State != models.GameStateNegotiation && g.State != models.GameStateInProgress {
+		return nil, nil
+	}
+
+	engine, err := loadGame(r, g)
+	if err != nil {
+		return nil, err
+	}
+
+	if engine.Handicap() < g.Handicap {
+		return nil, nil
+	}
+
+	return engine, nil
+}
+
+// legalMoves returns the points the player to move in g may play at, or nil
+// if no stone can be played.
+func legalMoves(r *repository.Repository, g *models.Game) ([]models.Point, error) {
+	engine, err := playableGame(r, g)
+	if engine == nil || err != nil {
+		return nil, err
+	}
+
+	return fromGamePoints(engine.LegalMoves()), nil
+}
+
+// previewMove reports what the player to move in g playing at x, y would do.
+func previewMove(r *repository.Repository, g *models.Game, x int, y int) (*models.MovePreview, error) {
+	engine, err := playableGame(r, g)
+	if err != nil {
+		return nil, err
+	}
+
+	if engine == nil {
+		return nil, errors.New("game is not being played")
+	}
+
+	captures, err := engine.TryMove(x, y)
+	if err == nil {
+		return &models.MovePreview{
+			Legal:    true,
+			Captures: fromGamePoints(captures),
+		}, nil
+	}
+
+	var violation models.MoveViolation
+	switch err.(type) {
+	case game.NonEmptyError:
+		violation = models.MoveViolationOccupied
+	case game.OutOfBoundsError:
+		violation = models.MoveViolationOutOfBounds
+	case game.KoViolationError:
+		violation = models.MoveViolationKo
+	case game.SuperkoViolationError:
+		violation = models.MoveViolationSuperko
+	case game.SuicideError:
+		violation = models.MoveViolationSuicide
+	default:
+		return nil, err
+	}
+
+	return &models.MovePreview{
+		Legal:     false,
+		Captures:  []models.Point{},
+		Violation: &violation,
+	}, nil
+}
@@ -0,0 +1,112 @@
+package gql
+
+import (
+	"errors"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+)
+
+// abortMovesPerPlayer is how many moves each player may make before a game
+// can no longer be aborted.
+const abortMovesPerPlayer = 2
+
+// loadLiveGame locks a game that has not finished on behalf of one of its
+// users.
+func loadLiveGame(r *repository.Repository, user models.User, gameId string) (*models.Game, []models.GameUserEdge, error) {
+	g, err := r.GetGameByIdForUpdate(gameId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if g.State == models.GameStateFinished {
+		return nil, nil, errors.New("game is finished")
+	}
+
+	users, err := r.GetUsersForGame(g.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, edge := range users {
+		if edge.User.Id == user.Id {
+			return g, users, nil
+		}
+	}
+
+	return nil, nil, errors.New("user is not in this game")
+}
+
+// resign ends the game as a loss for user.
+func resign(r *repository.Repository, user models.User, gameId string) (*models.Game, error) {
+	g, users, err := loadLiveGame(r, user, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) < 2 {
+		return nil, errors.New("game has not started")
+	}
+
+	color, err := colorForUser(users, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endGame(r, g, user, models.GameEventTypeResign, game.ResignationResult(color).String())
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// abort ends a game that has barely started without a result. Challenges
+// that nobody has accepted yet can always be aborted.
+func abort(r *repository.Repository, user models.User, gameId string) (*models.Game, error) {
+	g, _, err := loadLiveGame(r, user, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.State == models.GameStateScoring {
+		return nil, errors.New("game is being scored")
+	}
+
+	moves, err := r.GetMovesForGame(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(moves) >= 2*abortMovesPerPlayer {
+		return nil, errors.New("game is too far along to abort")
+	}
+
+	err = endGame(r, g, user, models.GameEventTypeAbort, models.ResultAborted)
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// endGame finishes g with result on behalf of user and tells the other player.
+func endGame(r *repository.Repository, g *models.Game, user models.User, eventType models.GameEventType, result string) error {
+	err := r.FinishGame(g.Id, result)
+	if err != nil {
+		return err
+	}
+
+	err = stopClocks(r, g.Id)
+	if err != nil {
+		return err
+	}
+
+	g.State = models.GameStateFinished
+	g.Result = &result
+	return publishGameEvent(r, g.Id, eventType, map[string]interface{}{
+		"state":      g.State.String(),
+		"gameResult": result,
+		"user":       user.Id,
+	})
+}
@@ -2,7 +2,10 @@ package gql
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"github.com/hashicorp/golang-lru"
 	"github.com/tengen-io/server/models"
 	"github.com/tengen-io/server/pubsub"
 	"github.com/tengen-io/server/repository"
@@ -12,6 +15,8 @@ import (
 type Resolver struct {
 	repo   *repository.Repository
 	auth auth
+	// positions caches the positions of finished games by positionKey.
+	positions *lru.Cache
 }
 
 func (r *Resolver) Mutation() MutationResolver {
@@ -26,6 +31,25 @@ func (r *Resolver) Game() GameResolver {
 func (r *Resolver) Subscription() SubscriptionResolver {
 	return &subscriptionResolver{r}
 }
+func (r *Resolver) Clock() ClockResolver {
+	return &clockResolver{r}
+}
+func (r *Resolver) Move() MoveResolver {
+	return &moveResolver{r}
+}
+func (r *Resolver) Point() PointResolver {
+	return &pointResolver{r}
+}
+
+type clockResolver struct{ *Resolver }
+
+func (r *clockResolver) MainTime(ctx context.Context, obj *models.Clock) (float64, error) {
+	return obj.MainTime.Seconds(), nil
+}
+
+func (r *clockResolver) PeriodTime(ctx context.Context, obj *models.Clock) (float64, error) {
+	return obj.PeriodTime.Seconds(), nil
+}
 
 type gameResolver struct{ *Resolver }
 
@@ -33,6 +57,83 @@ func (r *gameResolver) Users(ctx context.Context, obj *models.Game) ([]models.Ga
 	return r.repo.GetUsersForGame(obj.Id)
 }
 
+func (r *gameResolver) DeadStones(ctx context.Context, obj *models.Game) ([]models.Point, error) {
+	return r.repo.GetDeadStones(obj.Id)
+}
+
+func (r *gameResolver) HandicapStones(ctx context.Context, obj *models.Game) ([]models.Point, error) {
+	return r.repo.GetHandicapStones(obj.Id)
+}
+
+func (r *gameResolver) TimeControl(ctx context.Context, obj *models.Game) (*models.TimeControl, error) {
+	return r.repo.GetTimeControl(obj.Id)
+}
+
+func (r *gameResolver) Clocks(ctx context.Context, obj *models.Game) ([]models.Clock, error) {
+	return r.repo.GetClocks(obj.Id)
+}
+
+func (r *gameResolver) Moves(ctx context.Context, obj *models.Game) ([]models.Move, error) {
+	return r.repo.GetMovesForGame(obj.Id)
+}
+
+func (r *gameResolver) Sgf(ctx context.Context, obj *models.Game) (*string, error) {
+	sgf, err := gameSGF(r.repo, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sgf, nil
+}
+
+func (r *gameResolver) LegalMoves(ctx context.Context, obj *models.Game) ([]models.Point, error) {
+	return legalMoves(r.repo, obj)
+}
+
+func (r *gameResolver) PreviewMove(ctx context.Context, obj *models.Game, x *int, y *int, vertex *string) (*models.MovePreview, error) {
+	p, err := pointArg(obj.BoardSize, x, y, vertex)
+	if err != nil {
+		return nil, err
+	}
+
+	return previewMove(r.repo, obj, p.X, p.Y)
+}
+
+func (r *gameResolver) Position(ctx context.Context, obj *models.Game, move int) (*models.Position, error) {
+	key := positionKey{obj.Id, move}
+	if p, ok := r.positions.Get(key); ok {
+		return p.(*models.Position), nil
+	}
+
+	p, err := gamePosition(r.repo, obj, move)
+	if err != nil {
+		return nil, err
+	}
+
+	// a live game can still lose moves to a takeback
+	if obj.State == models.GameStateFinished {
+		r.positions.Add(key, p)
+	}
+
+	return p, nil
+}
+
+type moveResolver struct{ *Resolver }
+
+func (r *moveResolver) Vertex(ctx context.Context, obj *models.Move, notation *models.Notation, boardSize *int) (*string, error) {
+	if obj.Type != models.MoveTypeStone || obj.X == nil || obj.Y == nil {
+		return nil, nil
+	}
+
+	return vertex(*obj.X, *obj.Y, notation, boardSize)
+}
+
+type pointResolver struct{ *Resolver }
+
+func (r *pointResolver) Vertex(ctx context.Context, obj *models.Point, notation *models.Notation, boardSize *int) (*string, error) {
+	return vertex(obj.X, obj.Y, notation, boardSize)
+}
+
 type mutationResolver struct{ *Resolver }
 
 func (m mutationResolver) CreateMatchmakingRequest(ctx context.Context, input models.CreateMatchmakingRequestInput) (*models.CreateMatchmakingRequestPayload, error) {
@@ -41,9 +142,14 @@ func (m mutationResolver) CreateMatchmakingRequest(ctx context.Context, input mo
 		return nil, errors.New("invalid user")
 	}
 
+	ruleset := models.RulesetChinese
+	if input.Ruleset != nil {
+		ruleset = *input.Ruleset
+	}
+
 	var rv models.CreateMatchmakingRequestPayload
 	err := m.repo.WithTx(func(r *repository.Repository) error {
-		req, err := r.CreateMatchmakingRequest(identity.User, input.Delta)
+		req, err := r.CreateMatchmakingRequest(identity.User, input.Delta, ruleset)
 		rv.Request = req
 
 		if err != nil {
@@ -62,6 +168,304 @@ func (m mutationResolver) CreateMatchmakingRequest(ctx context.Context, input mo
 	return &rv, nil
 }
 
+func (m mutationResolver) PlayMove(ctx context.Context, gameId string, x *int, y *int, vertex *string) (*models.MovePayload, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.MovePayload
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		p, err := gamePointArg(r, gameId, x, y, vertex)
+		if err != nil {
+			return err
+		}
+
+		payload, err := makeMove(r, identity.User, gameId, models.MoveTypeStone, p.X, p.Y)
+		rv = payload
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) Pass(ctx context.Context, gameId string) (*models.MovePayload, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.MovePayload
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		payload, err := makeMove(r, identity.User, gameId, models.MoveTypePass, 0, 0)
+		rv = payload
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) ToggleDeadStones(ctx context.Context, gameId string, x *int, y *int, vertex *string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		p, err := gamePointArg(r, gameId, x, y, vertex)
+		if err != nil {
+			return err
+		}
+
+		g, err := toggleDeadStones(r, identity.User, gameId, p.X, p.Y)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) AcceptScore(ctx context.Context, gameId string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := acceptScore(r, identity.User, gameId)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) RejectScore(ctx context.Context, gameId string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := rejectScore(r, identity.User, gameId)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) ImportGame(ctx context.Context, sgf string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := importGame(r, identity.User, sgf)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) CreateChallenge(ctx context.Context, input models.CreateChallengeInput) (*models.CreateChallengePayload, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	color := models.ColorBlack
+	if input.Color != nil {
+		color = *input.Color
+	}
+
+	settings, err := gameSettings(input.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var rv models.CreateChallengePayload
+	err = m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := createChallenge(r, identity.User, color, settings)
+		if err != nil {
+			return err
+		}
+
+		rv.Game = *g
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &rv, nil
+}
+
+func (m mutationResolver) AcceptChallenge(ctx context.Context, gameId string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := acceptChallenge(r, identity.User, gameId)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) PlaceHandicap(ctx context.Context, gameId string, points []models.PointInput) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := r.GetGameById(gameId)
+		if err != nil {
+			return err
+		}
+
+		stones := make([]models.Point, len(points))
+		for i, p := range points {
+			point, err := pointArg(g.BoardSize, p.X, p.Y, p.Vertex)
+			if err != nil {
+				return err
+			}
+
+			stones[i] = models.Point{X: point.X, Y: point.Y}
+		}
+
+		rv, err = placeHandicap(r, identity.User, gameId, stones)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) Resign(ctx context.Context, gameId string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := resign(r, identity.User, gameId)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) Abort(ctx context.Context, gameId string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := abort(r, identity.User, gameId)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) RequestUndo(ctx context.Context, gameId string) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := requestUndo(r, identity.User, gameId)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
+func (m mutationResolver) RespondUndo(ctx context.Context, gameId string, accept bool) (*models.Game, error) {
+	identity, ok := ctx.Value(IdentityContextKey).(models.Identity)
+	if !ok {
+		return nil, errors.New("invalid user")
+	}
+
+	var rv *models.Game
+	err := m.repo.WithTx(func(r *repository.Repository) error {
+		g, err := respondUndo(r, identity.User, gameId, accept)
+		rv = g
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rv, nil
+}
+
 type queryResolver struct{ *Resolver }
 
 func (r *queryResolver) User(ctx context.Context, id *string, name *string) (*models.User, error) {
@@ -169,3 +573,101 @@ func (r *subscriptionResolver) MatchmakingRequestCompletions(ctx context.Context
 
 	return rv, nil
 }
+
+func (r *subscriptionResolver) GameEvents(ctx context.Context, gameId string) (<-chan *models.GameEvent, error) {
+	_, err := r.repo.GetGameById(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := make(chan *models.GameEvent, 5)
+	topic := pubsub.MkTopic(pubsub.TopicCategoryGames, gameId)
+	c := r.repo.Subscribe(topic)
+
+	go func() {
+		<-ctx.Done()
+		r.repo.Unsubscribe(topic, c)
+	}()
+
+	go func() {
+		defer close(rv)
+		for event := range c {
+			payload, err := r.gameEventPayload(gameId, event)
+			if err != nil {
+				log.Printf("unable to build game event for %+v: %s", event, err)
+				continue
+			}
+
+			select {
+			case rv <- payload:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return rv, nil
+}
+
+func (r *subscriptionResolver) gameEventPayload(gameId string, event pubsub.Event) (*models.GameEvent, error) {
+	eventType := models.GameEventType(event.Event)
+	if !eventType.IsValid() {
+		return nil, fmt.Errorf("unknown game event %s", event.Event)
+	}
+
+	game, err := r.repo.GetGameById(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &models.GameEvent{
+		Type: eventType,
+		Game: *game,
+	}
+
+	if moveId, ok := event.Payload["move"].(string); ok {
+		rv.Move, err = r.repo.GetMoveById(moveId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if result, ok := event.Payload["result"]; ok {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(b, &rv.Result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if gameResult, ok := event.Payload["gameResult"].(string); ok {
+		rv.GameResult = &gameResult
+	}
+
+	if clocks, ok := event.Payload["clocks"]; ok {
+		// round trip through JSON to get the clocks back out of the notification
+		b, err := json.Marshal(clocks)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(b, &rv.Clocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// numbers come back out of the JSON notification as float64
+	if black, ok := event.Payload["blackCaptures"].(float64); ok {
+		rv.Captures.Black = int(black)
+	}
+	if white, ok := event.Payload["whiteCaptures"].(float64); ok {
+		rv.Captures.White = int(white)
+	}
+
+	return rv, nil
+}
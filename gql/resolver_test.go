@@ -0,0 +1,65 @@
+package gql
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/pubsub"
+	"github.com/tengen-io/server/repository"
+	"github.com/tengen-io/server/test"
+	"testing"
+)
+
+// notified returns the event as a subscriber receives it, after the trip
+// through the JSON of a notification.
+func notified(t *testing.T, gameId string, eventType models.GameEventType, payload map[string]interface{}) pubsub.Event {
+	b, err := json.Marshal(pubsub.Event{Subject: gameId, Event: eventType.String(), Payload: payload})
+	assert.NoError(t, err)
+
+	var rv pubsub.Event
+	assert.NoError(t, json.Unmarshal(b, &rv))
+	return rv
+}
+
+func TestSubscriptionResolver_gameEventPayload(t *testing.T) {
+	repo := repository.NewRepository(test.DB(), test.PubSub())
+	r := &subscriptionResolver{&Resolver{repo: repo}}
+
+	user, err := repo.GetUserById("1")
+	assert.NoError(t, err)
+
+	g, err := repo.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese}, models.GameStateInProgress, []models.User{*user})
+	assert.NoError(t, err)
+
+	x, y := 2, 3
+	move, err := repo.CreateMove(g.Id, *user, 1, models.MoveTypeStone, &x, &y)
+	assert.NoError(t, err)
+
+	engine := game.NewGame(9)
+	result, err := engine.PlayMove(x, y)
+	assert.NoError(t, err)
+
+	event, err := r.gameEventPayload(g.Id, notified(t, g.Id, models.GameEventTypeMove, map[string]interface{}{
+		"move":          move.Id,
+		"result":        toMoveResult(result),
+		"blackCaptures": 0,
+		"whiteCaptures": 0,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, move.Id, event.Move.Id)
+	assert.Equal(t, &models.Point{X: x, Y: y}, event.Result.Point)
+	assert.Equal(t, models.ColorWhite, event.Result.Next)
+	assert.Nil(t, event.GameResult)
+
+	event, err = r.gameEventPayload(g.Id, notified(t, g.Id, models.GameEventTypeStateChange, map[string]interface{}{
+		"state":         models.GameStateFinished.String(),
+		"gameResult":    "B+R",
+		"blackCaptures": 1,
+		"whiteCaptures": 2,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "B+R", *event.GameResult)
+	assert.Nil(t, event.Result)
+	assert.Equal(t, models.Captures{Black: 1, White: 2}, event.Captures)
+}
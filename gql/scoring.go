@@ -0,0 +1,167 @@
+package gql
+
+import (
+	"errors"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+	"time"
+)
+
+// loadScoringGame locks a game in the SCORING state on behalf of one of its
+// players and rebuilds its final position.
+func loadScoringGame(r *repository.Repository, user models.User, gameId string) (*models.Game, []models.GameUserEdge, *game.Game, error) {
+	g, err := r.GetGameByIdForUpdate(gameId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if g.State != models.GameStateScoring {
+		return nil, nil, nil, errors.New("game is not being scored")
+	}
+
+	users, err := r.GetUsersForGame(g.Id)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	_, err = colorForUser(users, user)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	engine, err := loadGame(r, g)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return g, users, engine, nil
+}
+
+// toggleDeadStones marks the group containing x, y as dead, or as alive again
+// if it was already marked. Either way both players have to accept the score
+// again.
+func toggleDeadStones(r *repository.Repository, user models.User, gameId string, x int, y int) (*models.Game, error) {
+	g, _, engine, err := loadScoringGame(r, user, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	stones := engine.StringAt(x, y)
+	if stones == nil {
+		return nil, errors.New("there is no stone at that point")
+	}
+
+	dead, err := r.GetDeadStones(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	points := fromGamePoints(stones)
+
+	if containsPoint(dead, points[0]) {
+		err = r.RemoveDeadStones(g.Id, points)
+	} else {
+		err = r.AddDeadStones(g.Id, points)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ResetScoreAccepted(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = publishGameEvent(r, g.Id, models.GameEventTypeDeadStones, map[string]interface{}{
+		"blackCaptures": engine.Captures(game.Black),
+		"whiteCaptures": engine.Captures(game.White),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// acceptScore records that user agrees with the current dead stones. Once
+// every player has accepted, the game is scored and finished.
+func acceptScore(r *repository.Repository, user models.User, gameId string) (*models.Game, error) {
+	g, users, engine, err := loadScoringGame(r, user, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.SetScoreAccepted(g.Id, user.Id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	err = publishGameEvent(r, g.Id, models.GameEventTypeScoreAccepted, map[string]interface{}{
+		"user":          user.Id,
+		"blackCaptures": engine.Captures(game.Black),
+		"whiteCaptures": engine.Captures(game.White),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, edge := range users {
+		if !edge.ScoreAccepted && edge.User.Id != user.Id {
+			return g, nil
+		}
+	}
+
+	dead, err := r.GetDeadStones(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = finishGame(r, g, engine, toGamePoints(dead))
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// rejectScore ends the scoring phase without a result. The marked dead stones
+// are discarded and the game resumes where it left off.
+func rejectScore(r *repository.Repository, user models.User, gameId string) (*models.Game, error) {
+	g, _, engine, err := loadScoringGame(r, user, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ClearDeadStones(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ResetScoreAccepted(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = setGameState(r, g, engine, models.GameStateInProgress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = startClock(r, g.Id, engine.CurrentColor(), time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+func containsPoint(points []models.Point, p models.Point) bool {
+	for _, i := range points {
+		if i == p {
+			return true
+		}
+	}
+
+	return false
+}
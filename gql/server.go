@@ -6,6 +6,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/handler"
 	"github.com/gorilla/websocket"
+	"github.com/hashicorp/golang-lru"
 	"github.com/tengen-io/server/db"
 	"github.com/tengen-io/server/pubsub"
 	"github.com/tengen-io/server/repository"
@@ -50,6 +51,7 @@ func (s *server) Start() {
 	mux.Handle("/", handler.Playground("tengen.io | GraphQL", "/graphql"))
 	mux.Handle("/register", s.RegistrationHandler())
 	mux.Handle("/login", s.LoginHandler())
+	mux.Handle("/games/", s.GamesHandler())
 
 	h := enableCorsMiddleware(mux)
 	log.Printf("Listening on http://%s:%d", s.config.Host, s.config.Port)
@@ -121,10 +123,16 @@ func makeRepo() *repository.Repository {
 
 
 func makeSchema(repo *repository.Repository, auth auth) graphql.ExecutableSchema {
+	positions, err := lru.New(positionCacheSize)
+	if err != nil {
+		log.Fatal("Could not create position cache.", err)
+	}
+
 	return NewExecutableSchema(Config{
 		Resolvers: &Resolver{
-			repo: repo,
-			auth: auth,
+			repo:      repo,
+			auth:      auth,
+			positions: positions,
 		},
 		Directives: Directives(),
 	})
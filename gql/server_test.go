@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tengen-io/server/repository"
 	"github.com/tengen-io/server/test"
+	"golang.org/x/crypto/bcrypt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -71,7 +72,14 @@ func makeTestServer() *server {
 		"", 0, false,
 	}
 	repo := repository.NewRepository(test.DB(), test.PubSub())
-	return newServer(&config, nil, repo)
+	a := auth{
+		signingKey:  []byte("supersecret"),
+		jwtLifetime: 60,
+		repo:        *repo,
+		bcryptCost:  bcrypt.MinCost,
+	}
+
+	return newServer(&config, nil, a, repo)
 }
 
 func TestMain(m *testing.M) {
@@ -0,0 +1,178 @@
+package gql
+
+import (
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+	"strings"
+)
+
+// gameRecord collects the moves and players of g into a record that can be
+// exchanged with other Go software.
+func gameRecord(r *repository.Repository, g *models.Game) (*game.Record, error) {
+	rules, err := game.RulesetForName(string(g.Ruleset))
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := r.GetUsersForGame(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	moves, err := r.GetMovesForGame(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	stones, err := r.GetHandicapStones(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &game.Record{
+		Size:           g.BoardSize,
+		Komi:           g.Komi,
+		Handicap:       g.Handicap,
+		HandicapStones: toGamePoints(stones),
+		Rules:          rules,
+		Started:        g.CreatedAt,
+		Moves:          make([]game.Move, 0, len(moves)),
+	}
+
+	for _, edge := range users {
+		if edge.Type != models.GameUserEdgeTypePlayer {
+			continue
+		}
+
+		switch edge.Index {
+		case 0:
+			rv.Black = edge.User.Name
+		case 1:
+			rv.White = edge.User.Name
+		}
+	}
+
+	if g.BlackName != nil {
+		rv.Black = *g.BlackName
+	}
+	if g.WhiteName != nil {
+		rv.White = *g.WhiteName
+	}
+
+	if g.State == models.GameStateFinished {
+		rv.Finished = g.UpdatedAt
+		if g.Result != nil {
+			rv.Result = *g.Result
+		}
+
+		// SGF records games without a result as void
+		if rv.Result == models.ResultAborted {
+			rv.Result = "Void"
+		}
+	}
+
+	// Moves always alternate, and those of imported games are all recorded
+	// against the user who imported them, so colors follow from the order.
+	color := game.Black
+	if len(stones) > 0 {
+		color = game.White
+	}
+
+	for _, move := range moves {
+		switch move.Type {
+		case models.MoveTypeStone:
+			rv.Moves = append(rv.Moves, game.Move{Color: color, Point: game.Point{X: *move.X, Y: *move.Y}})
+		case models.MoveTypePass:
+			rv.Moves = append(rv.Moves, game.Move{Color: color, Pass: true})
+		}
+
+		if color == game.Black {
+			color = game.White
+		} else {
+			color = game.Black
+		}
+	}
+
+	return rv, nil
+}
+
+// gameSGF renders g as an SGF file.
+func gameSGF(r *repository.Repository, g *models.Game) (string, error) {
+	record, err := gameRecord(r, g)
+	if err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	err = game.WriteSGF(&sb, record)
+	if err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+// importGame replays an SGF record and stores it as a finished game in the
+// history of user. The moves are recorded against user, who joins the game
+// as a player if their name matches one in the record and as its owner
+// otherwise.
+func importGame(r *repository.Repository, user models.User, sgf string) (*models.Game, error) {
+	record, err := game.ReadSGF(strings.NewReader(sgf))
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := record.Replay()
+	if err != nil {
+		return nil, err
+	}
+
+	edgeType, index := models.GameUserEdgeTypeOwner, 0
+	switch user.Name {
+	case record.Black:
+		edgeType, index = models.GameUserEdgeTypePlayer, 0
+	case record.White:
+		edgeType, index = models.GameUserEdgeTypePlayer, 1
+	}
+
+	var result *string
+	if record.Result != "" {
+		result = &record.Result
+	}
+
+	settings := models.GameSettings{
+		BoardSize:         record.Size,
+		Ruleset:           models.Ruleset(engine.Rules().Name()),
+		Handicap:          engine.Handicap(),
+		HandicapPlacement: models.HandicapPlacementFree,
+		Komi:              record.Komi,
+	}
+
+	g, err := r.CreateImportedGame(settings, result, record.Black, record.White, record.Started, record.Finished, user, edgeType, index)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(record.HandicapStones) > 0 {
+		err = r.AddHandicapStones(g.Id, fromGamePoints(record.HandicapStones))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, move := range record.Moves {
+		if move.Pass {
+			_, err = r.CreateMove(g.Id, user, i+1, models.MoveTypePass, nil, nil)
+		} else {
+			x, y := move.Point.X, move.Point.Y
+			_, err = r.CreateMove(g.Id, user, i+1, models.MoveTypeStone, &x, &y)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
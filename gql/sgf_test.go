@@ -0,0 +1,23 @@
+package gql
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/tengen-io/server/repository"
+	"github.com/tengen-io/server/test"
+	"testing"
+)
+
+func TestImportGame_RoundTrip(t *testing.T) {
+	repo := repository.NewRepository(test.DB(), test.PubSub())
+
+	user, err := repo.GetUserById("1")
+	assert.NoError(t, err)
+
+	g, err := importGame(repo, *user, "(;GM[1]FF[4]SZ[9]PB[Honinbo Shusaku]PW[Gennan Inseki]RE[B+2]DT[1846-09-11,1846-09-15];B[ee];W[cc])")
+	assert.NoError(t, err)
+
+	sgf, err := gameSGF(repo, g)
+	assert.NoError(t, err)
+	assert.Contains(t, sgf, "DT[1846-09-11,1846-09-15]")
+	assert.Contains(t, sgf, "PB[Honinbo Shusaku]")
+}
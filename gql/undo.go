@@ -0,0 +1,166 @@
+package gql
+
+import (
+	"errors"
+	"github.com/tengen-io/server/game"
+	"github.com/tengen-io/server/models"
+	"github.com/tengen-io/server/repository"
+	"time"
+)
+
+// loadUndoGame locks a game that is being played and allows takebacks on
+// behalf of one of its players, and returns its last move.
+func loadUndoGame(r *repository.Repository, user models.User, gameId string) (*models.Game, *models.Move, error) {
+	g, err := r.GetGameByIdForUpdate(gameId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !g.UndoAllowed {
+		return nil, nil, errors.New("takebacks are not allowed in this game")
+	}
+
+	if g.State != models.GameStateNegotiation && g.State != models.GameStateInProgress {
+		return nil, nil, errors.New("game is not being played")
+	}
+
+	users, err := r.GetUsersForGame(g.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = colorForUser(users, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	moves, err := r.GetMovesForGame(g.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(moves) == 0 {
+		return nil, nil, errors.New("there is no move to take back")
+	}
+
+	return g, &moves[len(moves)-1], nil
+}
+
+// requestUndo asks the opponent of user to let them take back their last
+// move.
+func requestUndo(r *repository.Repository, user models.User, gameId string) (*models.Game, error) {
+	g, last, err := loadUndoGame(r, user, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if last.User.Id != user.Id {
+		return nil, errors.New("you can only take back your own last move")
+	}
+
+	if g.UndoRequest != nil {
+		return nil, errors.New("a takeback has already been requested")
+	}
+
+	err = r.SetUndoRequest(g.Id, &last.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	g.UndoRequest = &last.Number
+	err = publishGameEvent(r, g.Id, models.GameEventTypeUndoRequest, map[string]interface{}{
+		"move": last.Id,
+		"user": user.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// respondUndo answers a takeback request. An accepted takeback removes the
+// last move, which rebuilds the position with its captures and ko from the
+// remaining moves, and gives the mover back the time they spent on it.
+func respondUndo(r *repository.Repository, user models.User, gameId string, accept bool) (*models.Game, error) {
+	g, last, err := loadUndoGame(r, user, gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.UndoRequest == nil || *g.UndoRequest != last.Number {
+		return nil, errors.New("no takeback has been requested")
+	}
+
+	if last.User.Id == user.Id {
+		return nil, errors.New("you cannot answer your own takeback request")
+	}
+
+	err = r.SetUndoRequest(g.Id, nil)
+	if err != nil {
+		return nil, err
+	}
+	g.UndoRequest = nil
+
+	if !accept {
+		err = publishGameEvent(r, g.Id, models.GameEventTypeUndoDeclined, map[string]interface{}{
+			"user": user.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return g, nil
+	}
+
+	err = r.DeleteMove(g.Id, last.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.DeleteSnapshots(g.Id, last.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	engine, err := loadGame(r, g)
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := r.TakeClockHistory(g.Id, last.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	if prev != nil {
+		err = stopClocks(r, g.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now().UTC()
+		prev.RunningSince = &now
+		err = r.SaveClock(g.Id, *prev)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clocks, err := r.GetClocks(g.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = publishGameEvent(r, g.Id, models.GameEventTypeUndo, map[string]interface{}{
+		"user":          user.Id,
+		"blackCaptures": engine.Captures(game.Black),
+		"whiteCaptures": engine.Captures(game.White),
+		"clocks":        clocks,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
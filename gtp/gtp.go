@@ -0,0 +1,154 @@
+// Package gtp drives Go engines that speak version 2 of the Go Text Protocol.
+package gtp
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/tengen-io/server/game"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Engine is a GTP engine running as a subprocess, driven over its standard
+// input and output.
+type Engine struct {
+	cmd *exec.Cmd
+	in  io.WriteCloser
+	out *bufio.Reader
+}
+
+// Start runs the engine at name with args. The engine is killed if ctx is
+// done before it is closed.
+func Start(ctx context.Context, name string, args ...string) (*Engine, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Engine{
+		cmd: cmd,
+		in:  in,
+		out: bufio.NewReader(out),
+	}, nil
+}
+
+// Send sends a command to the engine and returns its response without the
+// leading "=". A failure response is returned as an Error.
+func (e *Engine) Send(command string, args ...string) (string, error) {
+	line := strings.Join(append([]string{command}, args...), " ")
+	_, err := io.WriteString(e.in, line+"\n")
+	if err != nil {
+		return "", err
+	}
+
+	// a response is one or more lines ended by an empty line
+	lines := make([]string, 0, 1)
+	for {
+		l, err := e.out.ReadString('\n')
+		if err != nil {
+			return "", fmt.Errorf("gtp: reading response to %s: %v", command, err)
+		}
+
+		l = strings.TrimRight(l, "\r\n")
+		if l == "" {
+			if len(lines) == 0 {
+				continue
+			}
+			break
+		}
+
+		lines = append(lines, l)
+	}
+
+	status, response := lines[0][0], strings.Join(lines, "\n")[1:]
+	response = strings.TrimSpace(response)
+	switch status {
+	case '=':
+		return response, nil
+	case '?':
+		return "", Error{Command: command, Message: response}
+	default:
+		return "", fmt.Errorf("gtp: malformed response to %s: %q", command, lines[0])
+	}
+}
+
+func (e *Engine) BoardSize(size int) error {
+	_, err := e.Send("boardsize", strconv.Itoa(size))
+	return err
+}
+
+func (e *Engine) ClearBoard() error {
+	_, err := e.Send("clear_board")
+	return err
+}
+
+func (e *Engine) Komi(komi float64) error {
+	_, err := e.Send("komi", strconv.FormatFloat(komi, 'f', -1, 64))
+	return err
+}
+
+// Play tells the engine that the player of color made move.
+func (e *Engine) Play(color game.Color, move Move) error {
+	_, err := e.Send("play", colorName(color), move.String())
+	return err
+}
+
+// GenMove asks the engine for the move of color on a board of the given size
+// and plays it on the engine's board.
+func (e *Engine) GenMove(color game.Color, size int) (Move, error) {
+	response, err := e.Send("genmove", colorName(color))
+	if err != nil {
+		return Move{}, err
+	}
+
+	return ParseMove(response, size)
+}
+
+// FinalScore asks the engine to score the game, such as "B+3.5".
+func (e *Engine) FinalScore() (string, error) {
+	return e.Send("final_score")
+}
+
+// Close asks the engine to quit and waits for it to exit.
+func (e *Engine) Close() error {
+	_, err := e.Send("quit")
+	e.in.Close()
+	waitErr := e.cmd.Wait()
+	if err != nil {
+		return err
+	}
+
+	return waitErr
+}
+
+func colorName(c game.Color) string {
+	if c == game.White {
+		return "white"
+	}
+
+	return "black"
+}
+
+// Error is a failure response from an engine.
+type Error struct {
+	Command string
+	Message string
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("gtp: %s failed: %s", e.Command, e.Message)
+}
@@ -0,0 +1,163 @@
+package gtp
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/tengen-io/server/game"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// The tests run their own binary as a fake engine: with GTP_FAKE_ENGINE set
+// it speaks GTP on stdin and stdout instead of running the tests.
+func TestMain(m *testing.M) {
+	if os.Getenv("GTP_FAKE_ENGINE") != "" {
+		fakeEngine(os.Stdin, os.Stdout)
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// fakeEngine is a minimal GTP engine that tracks the board with game.Game and
+// answers genmove with the first legal point.
+func fakeEngine(r io.Reader, w io.Writer) {
+	g := game.NewGame(19)
+	size := 19
+	komi := "0"
+	in := bufio.NewScanner(r)
+	for in.Scan() {
+		fields := strings.Fields(in.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		response, err := "", error(nil)
+		switch fields[0] {
+		case "protocol_version":
+			response = "2"
+		case "name":
+			response = "fake"
+		case "boardsize":
+			size, err = strconv.Atoi(fields[1])
+			g = game.NewGame(size)
+		case "clear_board":
+			g = game.NewGame(size)
+		case "komi":
+			komi = fields[1]
+		case "play":
+			var move Move
+			move, err = ParseMove(fields[2], size)
+			if err == nil && move.Pass {
+				g.Pass()
+			} else if err == nil {
+				_, err = g.PlayMove(move.Point.X, move.Point.Y)
+			}
+		case "genmove":
+			move := Move{Pass: true}
+			if legal := g.LegalMoves(); len(legal) > 0 {
+				move = Move{Point: legal[0]}
+				g.PlayMove(move.Point.X, move.Point.Y)
+			} else {
+				g.Pass()
+			}
+			response = move.String()
+		case "final_score":
+			response = "W+" + komi
+		case "quit":
+			fmt.Fprint(w, "=\n\n")
+			return
+		default:
+			err = fmt.Errorf("unknown command")
+		}
+
+		if err != nil {
+			fmt.Fprintf(w, "? %s\n\n", err)
+		} else {
+			fmt.Fprintf(w, "= %s\n\n", response)
+		}
+	}
+}
+
+func startFake(t *testing.T) *Engine {
+	os.Setenv("GTP_FAKE_ENGINE", "1")
+	defer os.Unsetenv("GTP_FAKE_ENGINE")
+
+	e, err := Start(context.Background(), os.Args[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return e
+}
+
+func TestEngine(t *testing.T) {
+	e := startFake(t)
+
+	version, err := e.Send("protocol_version")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", version)
+
+	assert.NoError(t, e.BoardSize(9))
+	assert.NoError(t, e.ClearBoard())
+	assert.NoError(t, e.Komi(6.5))
+	assert.NoError(t, e.Play(game.Black, Move{Point: game.Point{X: 0, Y: 0}}))
+	assert.NoError(t, e.Play(game.White, Move{Pass: true}))
+
+	move, err := e.GenMove(game.Black, 9)
+	assert.NoError(t, err)
+	assert.Equal(t, Move{Point: game.Point{X: 1, Y: 0}}, move)
+
+	score, err := e.FinalScore()
+	assert.NoError(t, err)
+	assert.Equal(t, "W+6.5", score)
+
+	assert.NoError(t, e.Close())
+}
+
+func TestEngine_Error(t *testing.T) {
+	e := startFake(t)
+	defer e.Close()
+
+	assert.NoError(t, e.BoardSize(9))
+	assert.NoError(t, e.Play(game.Black, Move{Point: game.Point{X: 4, Y: 4}}))
+
+	err := e.Play(game.White, Move{Point: game.Point{X: 4, Y: 4}})
+	assert.Equal(t, Error{Command: "play", Message: game.NonEmptyError{}.Error()}, err)
+
+	_, err = e.Send("showboard")
+	assert.IsType(t, Error{}, err)
+}
+
+func TestParseMove(t *testing.T) {
+	testCases := []struct {
+		vertex   string
+		expected Move
+	}{
+		{"A1", Move{Point: game.Point{X: 0, Y: 0}}},
+		{"d4", Move{Point: game.Point{X: 3, Y: 3}}},
+		{"J19", Move{Point: game.Point{X: 8, Y: 18}}},
+		{"T19", Move{Point: game.Point{X: 18, Y: 18}}},
+		{"pass", Move{Pass: true}},
+		{"resign", Move{Resign: true}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.vertex, func(t *testing.T) {
+			move, err := ParseMove(testCase.vertex, 19)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, move)
+			assert.Equal(t, strings.ToUpper(testCase.vertex), strings.ToUpper(move.String()))
+		})
+	}
+
+	for _, vertex := range []string{"I5", "U1", "A20", "A0", "5", ""} {
+		_, err := ParseMove(vertex, 19)
+		assert.Error(t, err, vertex)
+	}
+}
@@ -0,0 +1,464 @@
+package gtp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/tengen-io/server/game"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultBoardSize is the board a Server starts with until it is told
+// otherwise.
+const DefaultBoardSize = 19
+
+// errQuit is returned by the quit command to end Serve after its response is
+// written.
+var errQuit = errors.New("quit")
+
+// played is a play or genmove command the server carried out, kept so that
+// undo can replay the game without it.
+type played struct {
+	color game.Color
+	move  Move
+}
+
+// Server answers GTP commands from a controller such as gogui-twogtp, playing
+// the game with the rules engine in the game package. Moves it generates come
+// from the built-in bot.
+type Server struct {
+	rules    game.Ruleset
+	size     int
+	komi     float64
+	handicap []game.Point
+	moves    []played
+	game     *game.Game
+	bot      *game.Bot
+}
+
+func NewServer(rules game.Ruleset) *Server {
+	s := &Server{
+		rules: rules,
+		size:  DefaultBoardSize,
+		komi:  game.KomiFor(rules, 0),
+		bot:   game.NewBot(game.BotLevelSafe, time.Now().UnixNano()),
+	}
+	s.clear()
+
+	return s
+}
+
+type handler func(s *Server, args []string) (string, error)
+
+// handlers are the commands the server knows. It is filled in by init since
+// list_commands refers back to it.
+var handlers map[string]handler
+
+func init() {
+	handlers = map[string]handler{
+		"protocol_version":  func(s *Server, args []string) (string, error) { return "2", nil },
+		"name":              func(s *Server, args []string) (string, error) { return "tengen", nil },
+		"version":           func(s *Server, args []string) (string, error) { return "", nil },
+		"known_command":     (*Server).knownCommand,
+		"list_commands":     (*Server).listCommands,
+		"quit":              func(s *Server, args []string) (string, error) { return "", errQuit },
+		"boardsize":         (*Server).boardSize,
+		"clear_board":       func(s *Server, args []string) (string, error) { s.clear(); return "", nil },
+		"komi":              (*Server).setKomi,
+		"play":              (*Server).play,
+		"genmove":           (*Server).genMove,
+		"undo":              (*Server).undo,
+		"fixed_handicap":    (*Server).fixedHandicap,
+		"set_free_handicap": (*Server).setFreeHandicap,
+		"final_score":       (*Server).finalScore,
+		"final_status_list": (*Server).finalStatusList,
+		"showboard":         (*Server).showBoard,
+	}
+}
+
+// Serve reads commands from in and writes the responses to out until the
+// controller sends quit or closes in.
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		id, command, args := parseCommand(scanner.Text())
+		if command == "" {
+			continue
+		}
+
+		var response string
+		var err error
+		h, ok := handlers[command]
+		if ok {
+			response, err = h(s, args)
+		} else {
+			err = errors.New("unknown command")
+		}
+
+		status := "="
+		if err != nil && err != errQuit {
+			status, response = "?", err.Error()
+		}
+
+		if response != "" {
+			response = " " + response
+		}
+
+		_, writeErr := fmt.Fprintf(out, "%s%s%s\n\n", status, id, response)
+		if writeErr != nil {
+			return writeErr
+		}
+
+		if err == errQuit {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
+
+// parseCommand splits a line into its optional id, the command name and its
+// arguments, dropping comments and control characters as the protocol asks.
+func parseCommand(line string) (string, string, []string) {
+	if i := strings.IndexByte(line, '#'); i >= 0 {
+		line = line[:i]
+	}
+
+	line = strings.Map(func(r rune) rune {
+		switch {
+		case r == '\t':
+			return ' '
+		case r < ' ' || r == 127:
+			return -1
+		default:
+			return r
+		}
+	}, line)
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return "", "", nil
+	}
+
+	id := ""
+	if _, err := strconv.Atoi(fields[0]); err == nil {
+		id, fields = fields[0], fields[1:]
+		if len(fields) == 0 {
+			return "", "", nil
+		}
+	}
+
+	return id, strings.ToLower(fields[0]), fields[1:]
+}
+
+func (s *Server) clear() {
+	s.handicap = nil
+	s.moves = nil
+	s.game = game.NewGameWithRules(s.size, s.rules)
+}
+
+func (s *Server) knownCommand(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("syntax error")
+	}
+
+	_, ok := handlers[strings.ToLower(args[0])]
+	return strconv.FormatBool(ok), nil
+}
+
+func (s *Server) listCommands(args []string) (string, error) {
+	commands := make([]string, 0, len(handlers))
+	for c := range handlers {
+		commands = append(commands, c)
+	}
+	sort.Strings(commands)
+
+	return strings.Join(commands, "\n"), nil
+}
+
+func (s *Server) boardSize(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("syntax error")
+	}
+
+	size, err := strconv.Atoi(args[0])
+	if err != nil {
+		return "", errors.New("syntax error")
+	}
+
+	if size < 2 || size > game.MaxBoardSize {
+		return "", errors.New("unacceptable size")
+	}
+
+	s.size = size
+	s.clear()
+	return "", nil
+}
+
+func (s *Server) setKomi(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("syntax error")
+	}
+
+	komi, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return "", errors.New("syntax error")
+	}
+
+	s.komi = komi
+	return "", nil
+}
+
+func (s *Server) play(args []string) (string, error) {
+	if len(args) != 2 {
+		return "", errors.New("syntax error")
+	}
+
+	color, err := parseColor(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	move, err := ParseMove(args[1], s.size)
+	if err != nil || move.Resign {
+		return "", errors.New("syntax error")
+	}
+
+	err = s.apply(color, move)
+	if err != nil {
+		// hand the move back to whoever had it before
+		replayErr := s.replay(s.moves)
+		if replayErr != nil {
+			return "", replayErr
+		}
+
+		return "", errors.New("illegal move")
+	}
+
+	s.moves = append(s.moves, played{color, move})
+	return "", nil
+}
+
+func (s *Server) genMove(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("syntax error")
+	}
+
+	color, err := parseColor(args[0])
+	if err != nil {
+		return "", err
+	}
+
+	s.turn(color)
+	move := Move{Pass: true}
+	p, ok := s.bot.GenMove(s.game)
+	if ok {
+		move = Move{Point: p}
+	}
+
+	err = s.apply(color, move)
+	if err != nil {
+		return "", err
+	}
+
+	s.moves = append(s.moves, played{color, move})
+	return move.String(), nil
+}
+
+// turn gives the move to the player of color. The protocol lets a controller
+// play the same color twice, which must not count as a pass by the other
+// player in between.
+func (s *Server) turn(color game.Color) {
+	s.game.SetCurrentColor(color)
+}
+
+func (s *Server) apply(color game.Color, move Move) error {
+	s.turn(color)
+	if move.Pass {
+		s.game.Pass()
+		return nil
+	}
+
+	_, err := s.game.PlayMove(move.Point.X, move.Point.Y)
+	return err
+}
+
+// undo takes back the last move by replaying the game without it, since the
+// rules engine only moves forward.
+func (s *Server) undo(args []string) (string, error) {
+	if len(s.moves) == 0 {
+		return "", errors.New("cannot undo")
+	}
+
+	return "", s.replay(s.moves[:len(s.moves)-1])
+}
+
+// replay sets the game up again from the handicap stones and moves.
+func (s *Server) replay(moves []played) error {
+	handicap := s.handicap
+	s.clear()
+
+	if len(handicap) > 0 {
+		err := s.placeHandicap(handicap)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, m := range moves {
+		err := s.apply(m.color, m.move)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.moves = moves
+	return nil
+}
+
+func (s *Server) fixedHandicap(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("syntax error")
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return "", errors.New("syntax error")
+	}
+
+	points, err := game.HandicapPoints(s.size, n)
+	if err != nil {
+		return "", errors.New("invalid number of stones")
+	}
+
+	err = s.placeHandicap(points)
+	if err != nil {
+		return "", err
+	}
+
+	vertices := make([]string, len(points))
+	for i, p := range points {
+		vertices[i] = Move{Point: p}.String()
+	}
+
+	return strings.Join(vertices, " "), nil
+}
+
+func (s *Server) setFreeHandicap(args []string) (string, error) {
+	points := make([]game.Point, len(args))
+	for i, a := range args {
+		m, err := ParseMove(a, s.size)
+		if err != nil || m.Pass || m.Resign {
+			return "", errors.New("syntax error")
+		}
+
+		points[i] = m.Point
+	}
+
+	return "", s.placeHandicap(points)
+}
+
+func (s *Server) placeHandicap(points []game.Point) error {
+	err := s.game.PlaceHandicap(points)
+	if err != nil {
+		return err
+	}
+
+	s.handicap = points
+	return nil
+}
+
+// finalScore counts the position with the server's rules, treating every
+// stone on the board as alive.
+func (s *Server) finalScore(args []string) (string, error) {
+	result := s.game.Score(nil, s.komi).Result()
+	if result.Draw {
+		return "0", nil
+	}
+
+	return result.String(), nil
+}
+
+// finalStatusList lists the stones with a status. The server does not judge
+// life and death, so every stone is alive.
+func (s *Server) finalStatusList(args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("syntax error")
+	}
+
+	switch strings.ToLower(args[0]) {
+	case "alive":
+	case "dead", "seki":
+		return "", nil
+	default:
+		return "", errors.New("syntax error")
+	}
+
+	lists := make([]string, 0)
+	seen := make(map[game.Point]bool)
+	for y := s.size - 1; y >= 0; y-- {
+		for x := 0; x < s.size; x++ {
+			if seen[game.Point{X: x, Y: y}] {
+				continue
+			}
+
+			stones := s.game.StringAt(x, y)
+			if stones == nil {
+				continue
+			}
+
+			vertices := make([]string, len(stones))
+			for i, p := range stones {
+				seen[p] = true
+				vertices[i] = Move{Point: p}.String()
+			}
+
+			lists = append(lists, strings.Join(vertices, " "))
+		}
+	}
+
+	return strings.Join(lists, "\n"), nil
+}
+
+func (s *Server) showBoard(args []string) (string, error) {
+	var b strings.Builder
+	header := "  "
+	for x := 0; x < s.size; x++ {
+		header += " " + string(game.GTPColumns[x])
+	}
+
+	b.WriteString("\n" + header + "\n")
+	for y := s.size - 1; y >= 0; y-- {
+		fmt.Fprintf(&b, "%2d", y+1)
+		for x := 0; x < s.size; x++ {
+			c, ok := s.game.ColorAt(x, y)
+			switch {
+			case !ok:
+				b.WriteString(" .")
+			case c == game.Black:
+				b.WriteString(" X")
+			default:
+				b.WriteString(" O")
+			}
+		}
+		fmt.Fprintf(&b, " %d\n", y+1)
+	}
+	b.WriteString(header)
+
+	return b.String(), nil
+}
+
+func parseColor(s string) (game.Color, error) {
+	switch strings.ToLower(s) {
+	case "b", "black":
+		return game.Black, nil
+	case "w", "white":
+		return game.White, nil
+	default:
+		return 0, errors.New("syntax error")
+	}
+}
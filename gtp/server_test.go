@@ -0,0 +1,135 @@
+package gtp
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/tengen-io/server/game"
+	"strings"
+	"testing"
+)
+
+// session sends the commands to a new server and returns its responses.
+func session(t *testing.T, commands ...string) []string {
+	var out bytes.Buffer
+	err := NewServer(game.ChineseRules).Serve(strings.NewReader(strings.Join(commands, "\n")), &out)
+	assert.NoError(t, err)
+
+	responses := strings.Split(out.String(), "\n\n")
+	return responses[:len(responses)-1]
+}
+
+func TestServer(t *testing.T) {
+	responses := session(t,
+		"1 protocol_version",
+		"# a comment",
+		"boardsize 9",
+		"komi 6.5",
+		"play black C3",
+		"play white D3",
+		"play black D4",
+		"play white pass",
+		"play b D2",
+		"play w pass",
+		"play b E3",
+		"final_score",
+		"2 quit",
+		"name",
+	)
+
+	assert.Equal(t, []string{"=1 2", "=", "=", "=", "=", "=", "=", "=", "=", "=", "= B+74.5", "=2"}, responses)
+}
+
+func TestServer_Errors(t *testing.T) {
+	responses := session(t,
+		"boardsize 26",
+		"boardsize 9",
+		"play black E5",
+		"3 play white E5",
+		"play white E10",
+		"undo",
+		"undo",
+		"frobnicate",
+		"known_command play",
+		"known_command frobnicate",
+	)
+
+	assert.Equal(t, []string{
+		"? unacceptable size",
+		"=",
+		"=",
+		"?3 illegal move",
+		"? syntax error",
+		"=",
+		"? cannot undo",
+		"? unknown command",
+		"= true",
+		"= false",
+	}, responses)
+}
+
+func TestServer_SameColorTwice(t *testing.T) {
+	responses := session(t,
+		"boardsize 9",
+		"play black A1",
+		"play black B1",
+		"play black A1",
+		"undo",
+		"play white A2",
+		"showboard",
+	)
+
+	assert.Equal(t, "? illegal move", responses[3])
+	assert.Equal(t, "=", responses[5])
+	assert.Equal(t, strings.Join([]string{
+		"= ",
+		"   A B C D E F G H J",
+		" 9 . . . . . . . . . 9",
+		" 8 . . . . . . . . . 8",
+		" 7 . . . . . . . . . 7",
+		" 6 . . . . . . . . . 6",
+		" 5 . . . . . . . . . 5",
+		" 4 . . . . . . . . . 4",
+		" 3 . . . . . . . . . 3",
+		" 2 O . . . . . . . . 2",
+		" 1 X . . . . . . . . 1",
+		"   A B C D E F G H J",
+	}, "\n"), responses[6])
+}
+
+func TestServer_SameColorTwiceNoPassStones(t *testing.T) {
+	var out bytes.Buffer
+	err := NewServer(game.AGARules).Serve(strings.NewReader("boardsize 9\nkomi 0\nplay black A1\nplay black B1\nplay white C1\nfinal_score\n"), &out)
+	assert.NoError(t, err)
+
+	// a pass by white between black's moves would give black a pass stone
+	responses := strings.Split(out.String(), "\n\n")
+	assert.Equal(t, "= 0", responses[5])
+}
+
+func TestServer_Handicap(t *testing.T) {
+	responses := session(t,
+		"boardsize 9",
+		"fixed_handicap 2",
+		"genmove white",
+		"final_status_list alive",
+	)
+
+	assert.Equal(t, "= C3 G7", responses[1])
+
+	move, err := ParseMove(strings.TrimPrefix(responses[2], "= "), 9)
+	assert.NoError(t, err)
+	assert.False(t, move.Pass)
+
+	assert.ElementsMatch(t, []string{"C3", "G7", move.String()}, strings.Fields(strings.TrimPrefix(responses[3], "=")))
+}
+
+func TestServer_GenMove(t *testing.T) {
+	commands := []string{"boardsize 7"}
+	for i := 0; i < 100; i++ {
+		commands = append(commands, "genmove black", "genmove white")
+	}
+
+	for _, response := range session(t, commands...) {
+		assert.True(t, strings.HasPrefix(response, "="), response)
+	}
+}
@@ -0,0 +1,46 @@
+package gtp
+
+import (
+	"fmt"
+	"github.com/tengen-io/server/game"
+	"strings"
+)
+
+// Move is a GTP move: a stone on a vertex, a pass or, from genmove, a
+// resignation.
+type Move struct {
+	Point  game.Point
+	Pass   bool
+	Resign bool
+}
+
+// String formats the move as a GTP vertex such as "D4". Rows are numbered
+// from the bottom of the board, which is y = 0.
+func (m Move) String() string {
+	switch {
+	case m.Pass:
+		return "pass"
+	case m.Resign:
+		return "resign"
+	default:
+		return m.Point.Format(game.NotationGTP, 0)
+	}
+}
+
+// ParseMove reads a GTP vertex, pass or resign on a board of the given size.
+func ParseMove(s string, size int) (Move, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	switch s {
+	case "PASS":
+		return Move{Pass: true}, nil
+	case "RESIGN":
+		return Move{Resign: true}, nil
+	}
+
+	p, err := game.ParsePointAs(s, game.NotationGTP, size)
+	if err != nil {
+		return Move{}, fmt.Errorf("gtp: invalid vertex %q", s)
+	}
+
+	return Move{Point: p}, nil
+}
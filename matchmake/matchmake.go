@@ -2,6 +2,7 @@ package matchmake
 
 import (
 	"github.com/tengen-io/server/db"
+	"github.com/tengen-io/server/game"
 	"github.com/tengen-io/server/models"
 	"github.com/tengen-io/server/pubsub"
 	"github.com/tengen-io/server/repository"
@@ -31,8 +32,21 @@ func (p DbPool) match(i models.MatchmakingRequest, j models.MatchmakingRequest)
 		{NodeFields: models.NodeFields{Id: i.User.Id}}, {NodeFields: models.NodeFields{Id: j.User.Id}},
 	}
 
-	err := p.repo.WithTx(func(r *repository.Repository) error {
-		game, err := r.CreateGame(models.GameTypeStandard, 19, models.GameStateNegotiation, users)
+	rules, err := game.RulesetForName(string(i.Ruleset))
+	if err != nil {
+		return err
+	}
+
+	settings := models.GameSettings{
+		BoardSize: 19,
+		Ruleset:   i.Ruleset,
+		Komi:      game.KomiFor(rules, 0),
+		// matched games are ranked, so they do not allow takebacks
+		UndoAllowed: false,
+	}
+
+	err = p.repo.WithTx(func(r *repository.Repository) error {
+		game, err := r.CreateGame(models.GameTypeStandard, settings, models.GameStateNegotiation, users)
 		if err != nil {
 			return err
 		}
@@ -134,6 +148,10 @@ func (m *matchmaker) tick() ([]match, error) {
 			}
 
 			r2 := requests[j]
+			if r1.Ruleset != r2.Ruleset {
+				continue
+			}
+
 			delta := abs(r1.Rank - r2.Rank)
 			if delta < r1.Delta && delta < r2.Delta {
 				pairs = append(pairs, r2)
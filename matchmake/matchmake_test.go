@@ -33,6 +33,11 @@ func Test_tick(t *testing.T) {
 			[]models.MatchmakingRequest{r(1, 1, 5, 3), r(2, 2, 3, 3)},
 			[]struct{ i, j string }{{"1", "2"}},
 		},
+		{
+			"different rulesets",
+			[]models.MatchmakingRequest{r(1, 1, 5, 3), withRuleset(r(2, 2, 3, 3), models.RulesetJapanese)},
+			[]struct{ i, j string }{},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -59,6 +64,7 @@ func Test_tick(t *testing.T) {
 			}
 
 			assert.Len(t, testCase.expected, found)
+			assert.Len(t, matches, len(testCase.expected))
 		})
 	}
 }
@@ -76,3 +82,8 @@ func r(id int64, user int, rank, delta int) models.MatchmakingRequest {
 		Delta:      delta,
 	}
 }
+
+func withRuleset(request models.MatchmakingRequest, ruleset models.Ruleset) models.MatchmakingRequest {
+	request.Ruleset = ruleset
+	return request
+}
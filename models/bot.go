@@ -0,0 +1,25 @@
+package models
+
+type BotType string
+
+const (
+	// BotTypeGTP bots are external engines driven over the Go Text Protocol.
+	BotTypeGTP BotType = "GTP"
+	// BotTypeBuiltin bots are played by game.Bot.
+	BotTypeBuiltin BotType = "BUILTIN"
+)
+
+// Bot is a user whose moves tengen plays on its own. Command is the command
+// line that starts a GTP bot's engine, or the level of a built-in bot.
+type Bot struct {
+	User    User
+	Type    BotType
+	Command string
+}
+
+// BotGame is a game being played with a bot seated at Index.
+type BotGame struct {
+	GameId string
+	Index  int
+	Bot    Bot
+}
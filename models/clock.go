@@ -0,0 +1,56 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TimeControl is the time control a game is played under. Times are in
+// seconds.
+type TimeControl struct {
+	System      TimeControlSystem `json:"system"`
+	MainTime    int               `json:"mainTime" db:"main_time"`
+	Increment   int               `json:"increment"`
+	MaxTime     int               `json:"maxTime" db:"max_time"`
+	Periods     int               `json:"periods"`
+	PeriodTime  int               `json:"periodTime" db:"period_time"`
+	PeriodMoves int               `json:"periodMoves" db:"period_moves"`
+}
+
+// Clock is the time the player at Index has left. RunningSince is when their
+// clock was started, or nil while it is stopped.
+type Clock struct {
+	Index        int           `json:"index"`
+	MainTime     time.Duration `json:"mainTime"`
+	Periods      int           `json:"periods"`
+	PeriodTime   time.Duration `json:"periodTime"`
+	PeriodMoves  int           `json:"periodMoves"`
+	RunningSince *time.Time    `json:"runningSince"`
+}
+
+// RunningClock is a clock that is counting down, along with the time control
+// of its game.
+type RunningClock struct {
+	GameId      string
+	Clock       Clock
+	TimeControl TimeControl
+}
+
+func (t *TimeControlSystem) Scan(value interface{}) error {
+	val, ok := value.([]byte)
+	if !ok {
+		return errors.New("cannot scan non-[]byte as timecontrolsystem")
+	}
+
+	*t = TimeControlSystem(string(val))
+	if !t.IsValid() {
+		return fmt.Errorf("%s is not a valid TimeControlSystem", string(val))
+	}
+	return nil
+}
+
+func (t TimeControlSystem) Value() (driver.Value, error) {
+	return t.String(), nil
+}
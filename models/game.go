@@ -14,6 +14,7 @@ const (
 	GameStateNegotiation GameState = iota
 	GameStateInProgress
 	GameStateFinished
+	GameStateScoring
 )
 
 func GameStateForString(str string) (GameState, error) {
@@ -24,6 +25,8 @@ func GameStateForString(str string) (GameState, error) {
 		return GameStateInProgress, nil
 	case "FINISHED":
 		return GameStateFinished, nil
+	case "SCORING":
+		return GameStateScoring, nil
 	default:
 		return 0, fmt.Errorf("unknown gamestate %s", str)
 	}
@@ -37,6 +40,8 @@ func (g GameState) String() string {
 		return "IN_PROGRESS"
 	case GameStateFinished:
 		return "FINISHED"
+	case GameStateScoring:
+		return "SCORING"
 	default:
 		return "UNKNOWN"
 	}
@@ -74,3 +79,20 @@ func (g *GameState) Scan(value interface{}) error {
 func (g GameState) Value() (driver.Value, error) {
 	return g.String(), nil
 }
+
+// ResultAborted is recorded as the result of games that were called off
+// before they got going.
+const ResultAborted = "ABORTED"
+
+// GameSettings are the terms a game is played under, agreed before it starts.
+type GameSettings struct {
+	BoardSize         int
+	Ruleset           Ruleset
+	Handicap          int
+	HandicapPlacement HandicapPlacement
+	// Komi is the points White receives for moving second.
+	Komi float64
+	// TimeControl is nil for untimed games.
+	TimeControl *TimeControl
+	UndoAllowed bool
+}
@@ -12,24 +12,148 @@ type Node interface {
 	IsNode()
 }
 
+type Captures struct {
+	Black int `json:"black"`
+	White int `json:"white"`
+}
+
+type CreateChallengeInput struct {
+	Color    *Color            `json:"color"`
+	Settings GameSettingsInput `json:"settings"`
+}
+
+type CreateChallengePayload struct {
+	Game Game `json:"game"`
+}
+
 type CreateMatchmakingRequestInput struct {
-	Delta int `json:"delta"`
+	Delta   int      `json:"delta"`
+	Ruleset *Ruleset `json:"ruleset"`
 }
 
 type CreateMatchmakingRequestPayload struct {
 	Request *MatchmakingRequest `json:"request"`
 }
 
+type GameEvent struct {
+	Type       GameEventType `json:"type"`
+	Game       Game          `json:"game"`
+	Move       *Move         `json:"move"`
+	Result     *MoveResult   `json:"result"`
+	GameResult *string       `json:"gameResult"`
+	Captures   Captures      `json:"captures"`
+	Clocks     []Clock       `json:"clocks"`
+}
+
+type GameSettingsInput struct {
+	BoardSize         *int               `json:"boardSize"`
+	Ruleset           *Ruleset           `json:"ruleset"`
+	Handicap          *int               `json:"handicap"`
+	HandicapPlacement *HandicapPlacement `json:"handicapPlacement"`
+	Komi              *float64           `json:"komi"`
+	TimeControl       *TimeControlInput  `json:"timeControl"`
+	UndoAllowed       *bool              `json:"undoAllowed"`
+}
+
 type GameUserEdge struct {
-	Index int              `json:"index"`
-	User  User             `json:"user"`
-	Type  GameUserEdgeType `json:"type"`
+	Index         int              `json:"index"`
+	User          User             `json:"user"`
+	Type          GameUserEdgeType `json:"type"`
+	ScoreAccepted bool             `json:"scoreAccepted"`
 }
 
 type MatchmakingRequestCompletionPayload struct {
 	Game Game `json:"game"`
 }
 
+type MovePayload struct {
+	Game   Game       `json:"game"`
+	Move   Move       `json:"move"`
+	Result MoveResult `json:"result"`
+}
+
+type MovePreview struct {
+	Legal     bool           `json:"legal"`
+	Captures  []Point        `json:"captures"`
+	Violation *MoveViolation `json:"violation"`
+}
+
+type MoveResult struct {
+	Color    Color    `json:"color"`
+	Point    *Point   `json:"point"`
+	Captured []Point  `json:"captured"`
+	Ko       *Point   `json:"ko"`
+	Captures Captures `json:"captures"`
+	Next     Color    `json:"next"`
+}
+
+type PointInput struct {
+	X      *int    `json:"x"`
+	Y      *int    `json:"y"`
+	Vertex *string `json:"vertex"`
+}
+
+type Position struct {
+	Move          int        `json:"move"`
+	ToMove        Color      `json:"toMove"`
+	Board         [][]*Color `json:"board"`
+	BlackCaptures int        `json:"blackCaptures"`
+	WhiteCaptures int        `json:"whiteCaptures"`
+	Ko            *Point     `json:"ko"`
+	LastMove      *Move      `json:"lastMove"`
+}
+
+type TimeControlInput struct {
+	System      TimeControlSystem `json:"system"`
+	MainTime    *int              `json:"mainTime"`
+	Increment   *int              `json:"increment"`
+	MaxTime     *int              `json:"maxTime"`
+	Periods     *int              `json:"periods"`
+	PeriodTime  *int              `json:"periodTime"`
+	PeriodMoves *int              `json:"periodMoves"`
+}
+
+type Color string
+
+const (
+	ColorBlack Color = "BLACK"
+	ColorWhite Color = "WHITE"
+)
+
+var AllColor = []Color{
+	ColorBlack,
+	ColorWhite,
+}
+
+func (e Color) IsValid() bool {
+	switch e {
+	case ColorBlack, ColorWhite:
+		return true
+	}
+	return false
+}
+
+func (e Color) String() string {
+	return string(e)
+}
+
+func (e *Color) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Color(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Color", str)
+	}
+	return nil
+}
+
+func (e Color) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Event string
 
 const (
@@ -73,6 +197,65 @@ func (e Event) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type GameEventType string
+
+const (
+	GameEventTypeMove          GameEventType = "MOVE"
+	GameEventTypePass          GameEventType = "PASS"
+	GameEventTypeStateChange   GameEventType = "STATE_CHANGE"
+	GameEventTypeDeadStones    GameEventType = "DEAD_STONES"
+	GameEventTypeScoreAccepted GameEventType = "SCORE_ACCEPTED"
+	GameEventTypeHandicap      GameEventType = "HANDICAP"
+	GameEventTypeResign        GameEventType = "RESIGN"
+	GameEventTypeAbort         GameEventType = "ABORT"
+	GameEventTypeUndoRequest   GameEventType = "UNDO_REQUEST"
+	GameEventTypeUndo          GameEventType = "UNDO"
+	GameEventTypeUndoDeclined  GameEventType = "UNDO_DECLINED"
+)
+
+var AllGameEventType = []GameEventType{
+	GameEventTypeMove,
+	GameEventTypePass,
+	GameEventTypeStateChange,
+	GameEventTypeDeadStones,
+	GameEventTypeScoreAccepted,
+	GameEventTypeHandicap,
+	GameEventTypeResign,
+	GameEventTypeAbort,
+	GameEventTypeUndoRequest,
+	GameEventTypeUndo,
+	GameEventTypeUndoDeclined,
+}
+
+func (e GameEventType) IsValid() bool {
+	switch e {
+	case GameEventTypeMove, GameEventTypePass, GameEventTypeStateChange, GameEventTypeDeadStones, GameEventTypeScoreAccepted, GameEventTypeHandicap, GameEventTypeResign, GameEventTypeAbort, GameEventTypeUndoRequest, GameEventTypeUndo, GameEventTypeUndoDeclined:
+		return true
+	}
+	return false
+}
+
+func (e GameEventType) String() string {
+	return string(e)
+}
+
+func (e *GameEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GameEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GameEventType", str)
+	}
+	return nil
+}
+
+func (e GameEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type GameUserEdgeType string
 
 const (
@@ -113,3 +296,267 @@ func (e *GameUserEdgeType) UnmarshalGQL(v interface{}) error {
 func (e GameUserEdgeType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type HandicapPlacement string
+
+const (
+	HandicapPlacementFixed HandicapPlacement = "FIXED"
+	HandicapPlacementFree  HandicapPlacement = "FREE"
+)
+
+var AllHandicapPlacement = []HandicapPlacement{
+	HandicapPlacementFixed,
+	HandicapPlacementFree,
+}
+
+func (e HandicapPlacement) IsValid() bool {
+	switch e {
+	case HandicapPlacementFixed, HandicapPlacementFree:
+		return true
+	}
+	return false
+}
+
+func (e HandicapPlacement) String() string {
+	return string(e)
+}
+
+func (e *HandicapPlacement) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = HandicapPlacement(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid HandicapPlacement", str)
+	}
+	return nil
+}
+
+func (e HandicapPlacement) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MoveType string
+
+const (
+	MoveTypeStone MoveType = "STONE"
+	MoveTypePass  MoveType = "PASS"
+)
+
+var AllMoveType = []MoveType{
+	MoveTypeStone,
+	MoveTypePass,
+}
+
+func (e MoveType) IsValid() bool {
+	switch e {
+	case MoveTypeStone, MoveTypePass:
+		return true
+	}
+	return false
+}
+
+func (e MoveType) String() string {
+	return string(e)
+}
+
+func (e *MoveType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MoveType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MoveType", str)
+	}
+	return nil
+}
+
+func (e MoveType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type MoveViolation string
+
+const (
+	MoveViolationOccupied    MoveViolation = "OCCUPIED"
+	MoveViolationOutOfBounds MoveViolation = "OUT_OF_BOUNDS"
+	MoveViolationKo          MoveViolation = "KO"
+	MoveViolationSuperko     MoveViolation = "SUPERKO"
+	MoveViolationSuicide     MoveViolation = "SUICIDE"
+)
+
+var AllMoveViolation = []MoveViolation{
+	MoveViolationOccupied,
+	MoveViolationOutOfBounds,
+	MoveViolationKo,
+	MoveViolationSuperko,
+	MoveViolationSuicide,
+}
+
+func (e MoveViolation) IsValid() bool {
+	switch e {
+	case MoveViolationOccupied, MoveViolationOutOfBounds, MoveViolationKo, MoveViolationSuperko, MoveViolationSuicide:
+		return true
+	}
+	return false
+}
+
+func (e MoveViolation) String() string {
+	return string(e)
+}
+
+func (e *MoveViolation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MoveViolation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MoveViolation", str)
+	}
+	return nil
+}
+
+func (e MoveViolation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Notation string
+
+const (
+	NotationGtp     Notation = "GTP"
+	NotationSgf     Notation = "SGF"
+	NotationNumeric Notation = "NUMERIC"
+)
+
+var AllNotation = []Notation{
+	NotationGtp,
+	NotationSgf,
+	NotationNumeric,
+}
+
+func (e Notation) IsValid() bool {
+	switch e {
+	case NotationGtp, NotationSgf, NotationNumeric:
+		return true
+	}
+	return false
+}
+
+func (e Notation) String() string {
+	return string(e)
+}
+
+func (e *Notation) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Notation(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Notation", str)
+	}
+	return nil
+}
+
+func (e Notation) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Ruleset string
+
+const (
+	RulesetChinese     Ruleset = "CHINESE"
+	RulesetJapanese    Ruleset = "JAPANESE"
+	RulesetAga         Ruleset = "AGA"
+	RulesetNewZealand  Ruleset = "NEW_ZEALAND"
+	RulesetTrompTaylor Ruleset = "TROMP_TAYLOR"
+)
+
+var AllRuleset = []Ruleset{
+	RulesetChinese,
+	RulesetJapanese,
+	RulesetAga,
+	RulesetNewZealand,
+	RulesetTrompTaylor,
+}
+
+func (e Ruleset) IsValid() bool {
+	switch e {
+	case RulesetChinese, RulesetJapanese, RulesetAga, RulesetNewZealand, RulesetTrompTaylor:
+		return true
+	}
+	return false
+}
+
+func (e Ruleset) String() string {
+	return string(e)
+}
+
+func (e *Ruleset) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Ruleset(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Ruleset", str)
+	}
+	return nil
+}
+
+func (e Ruleset) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TimeControlSystem string
+
+const (
+	TimeControlSystemAbsolute TimeControlSystem = "ABSOLUTE"
+	TimeControlSystemFischer  TimeControlSystem = "FISCHER"
+	TimeControlSystemByoYomi  TimeControlSystem = "BYO_YOMI"
+	TimeControlSystemCanadian TimeControlSystem = "CANADIAN"
+)
+
+var AllTimeControlSystem = []TimeControlSystem{
+	TimeControlSystemAbsolute,
+	TimeControlSystemFischer,
+	TimeControlSystemByoYomi,
+	TimeControlSystemCanadian,
+}
+
+func (e TimeControlSystem) IsValid() bool {
+	switch e {
+	case TimeControlSystemAbsolute, TimeControlSystemFischer, TimeControlSystemByoYomi, TimeControlSystemCanadian:
+		return true
+	}
+	return false
+}
+
+func (e TimeControlSystem) String() string {
+	return string(e)
+}
+
+func (e *TimeControlSystem) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TimeControlSystem(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TimeControlSystem", str)
+	}
+	return nil
+}
+
+func (e TimeControlSystem) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
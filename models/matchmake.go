@@ -6,6 +6,7 @@ type MatchmakingRequest struct {
 	User User
 	Rank   int
 	Delta  int
+	Ruleset Ruleset
 }
 
 func (MatchmakingRequest) IsNode() {}
@@ -35,12 +35,15 @@ type GameType int8
 
 const (
 	GameTypeStandard GameType = iota
+	GameTypeImported
 )
 
 func (g GameType) String() string {
 	switch g {
 	case GameTypeStandard:
 		return "STANDARD"
+	case GameTypeImported:
+		return "IMPORTED"
 	default:
 		return "UNKNOWN"
 	}
@@ -50,6 +53,8 @@ func GameTypeForString(str string) (GameType, error) {
 	switch str {
 	case "STANDARD":
 		return GameTypeStandard, nil
+	case "IMPORTED":
+		return GameTypeImported, nil
 	default:
 		return 0, fmt.Errorf("unknown gametype %s", str)
 	}
@@ -93,6 +98,23 @@ type Game struct {
 	BoardSize int       `json:"boardSize" db:"board_size"`
 	Type      GameType  `json:"type"`
 	State     GameState `json:"state"`
+	Ruleset   Ruleset   `json:"ruleset"`
+	Result    *string   `json:"result"`
+	Handicap  int       `json:"handicap"`
+	Komi      float64   `json:"komi"`
+	// UndoAllowed is false for games where takebacks are not allowed, such
+	// as ranked games.
+	UndoAllowed bool `json:"undoAllowed" db:"undo_allowed"`
+	// UndoRequest is the number of the move a takeback was asked for, while
+	// the opponent has not answered.
+	UndoRequest *int `json:"undoRequest" db:"undo_request"`
+	// HandicapPlacement decides whether the handicap stones go on the star
+	// points or wherever Black places them.
+	HandicapPlacement HandicapPlacement `json:"handicapPlacement" db:"handicap_placement"`
+	// BlackName and WhiteName are the players named in an imported record,
+	// who need not have accounts.
+	BlackName *string `json:"blackName" db:"black_name"`
+	WhiteName *string `json:"whiteName" db:"white_name"`
 }
 
 func (Game) IsNode() {}
@@ -114,6 +136,46 @@ func (g GameUserEdgeType) Value() (driver.Value, error) {
 	return g.String(), nil
 }
 
+func (r *Ruleset) Scan(value interface{}) error {
+	val, ok := value.([]byte)
+	if !ok {
+		return errors.New("cannot scan non-[]byte as ruleset")
+	}
+
+	*r = Ruleset(string(val))
+	if !r.IsValid() {
+		return fmt.Errorf("%s is not a valid Ruleset", string(val))
+	}
+	return nil
+}
+
+func (r Ruleset) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+func (h *HandicapPlacement) Scan(value interface{}) error {
+	val, ok := value.([]byte)
+	if !ok {
+		return errors.New("cannot scan non-[]byte as handicap placement")
+	}
+
+	*h = HandicapPlacement(string(val))
+	if !h.IsValid() {
+		return fmt.Errorf("%s is not a valid HandicapPlacement", string(val))
+	}
+	return nil
+}
+
+// Value stores the zero HandicapPlacement as FIXED, which is what games
+// without a handicap are recorded with.
+func (h HandicapPlacement) Value() (driver.Value, error) {
+	if h == "" {
+		return HandicapPlacementFixed.String(), nil
+	}
+
+	return h.String(), nil
+}
+
 func MarshalTimestamp(t time.Time) graphql.Marshaler {
 	return graphql.WriterFunc(func(w io.Writer) {
 		io.WriteString(w, strconv.FormatInt(t.UTC().Unix(), 10))
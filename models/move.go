@@ -0,0 +1,41 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+type Move struct {
+	NodeFields
+	Type   MoveType `json:"type"`
+	Number int      `json:"number"`
+	X      *int     `json:"x"`
+	Y      *int     `json:"y"`
+	User   User     `json:"user"`
+}
+
+func (Move) IsNode() {}
+
+// Point is a point on the board, with y counted from the bottom row.
+type Point struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func (m *MoveType) Scan(value interface{}) error {
+	val, ok := value.([]byte)
+	if !ok {
+		return errors.New("cannot scan non-[]byte as movetype")
+	}
+
+	*m = MoveType(string(val))
+	if !m.IsValid() {
+		return fmt.Errorf("%s is not a valid MoveType", string(val))
+	}
+	return nil
+}
+
+func (m MoveType) Value() (driver.Value, error) {
+	return m.String(), nil
+}
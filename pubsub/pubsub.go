@@ -6,6 +6,7 @@ import (
 	"github.com/lib/pq"
 	"github.com/olebedev/emitter"
 	"log"
+	"sync"
 	"time"
 )
 
@@ -14,8 +15,14 @@ type Topic string
 
 const (
 	TopicCategoryMatchmakeRequests TopicCategory = "matchmake_requests"
+	TopicCategoryGames             TopicCategory = "games"
 )
 
+var topicCategories = []TopicCategory{
+	TopicCategoryMatchmakeRequests,
+	TopicCategoryGames,
+}
+
 type PubSub interface {
 	Publish(topic TopicCategory, payload Event) error
 	Subscribe(topic Topic) <-chan Event
@@ -24,12 +31,15 @@ type PubSub interface {
 type DbPubSub struct {
 	connString string
 	e *emitter.Emitter
+
+	mu            sync.Mutex
+	subscriptions map[<-chan Event]<-chan emitter.Event
 }
 
 type Event struct {
 	Subject string `json:"subject"`
 	Event string `json:"event"`
-	Payload map[string]interface{} `json:payload`
+	Payload map[string]interface{} `json:"payload"`
 }
 
 func MkTopic(category TopicCategory, subject string) Topic {
@@ -37,10 +47,10 @@ func MkTopic(category TopicCategory, subject string) Topic {
 }
 
 func NewDbPubSub(connString string)  *DbPubSub {
-	emitter := emitter.New(50)
 	return &DbPubSub{
 		connString: connString,
-		e: emitter,
+		e: emitter.New(50),
+		subscriptions: make(map[<-chan Event]<-chan emitter.Event),
 	}
 }
 
@@ -53,7 +63,12 @@ func (d *DbPubSub) Subscribe(topic Topic) <-chan Event {
 	log.Printf("listening on %s", string(topic))
 	rv := make(chan Event, 10)
 
+	d.mu.Lock()
+	d.subscriptions[rv] = raw
+	d.mu.Unlock()
+
 	go func() {
+		defer close(rv)
 		for rawEvent := range raw {
 			log.Printf("pubsub: got event %+v", rawEvent)
 			if len(rawEvent.Args) > 0 {
@@ -71,6 +86,18 @@ func (d *DbPubSub) Subscribe(topic Topic) <-chan Event {
 	return rv
 }
 
+// Unsubscribe stops delivery to a channel returned by Subscribe and closes it.
+func (d *DbPubSub) Unsubscribe(topic Topic, c <-chan Event) {
+	d.mu.Lock()
+	raw, ok := d.subscriptions[c]
+	delete(d.subscriptions, c)
+	d.mu.Unlock()
+
+	if ok {
+		d.e.Off(string(topic), raw)
+	}
+}
+
 func (d *DbPubSub) listen() {
 	eventChannel := func(ev pq.ListenerEventType, err error) {
 		if err != nil {
@@ -80,17 +107,20 @@ func (d *DbPubSub) listen() {
 
 	listener := pq.NewListener(d.connString, time.Duration(10) * time.Millisecond, time.Duration(1) * time.Second, eventChannel)
 
-	err := listener.Listen(string(TopicCategoryMatchmakeRequests))
-	if err != nil {
-		panic(fmt.Sprintf("pubsub: could not listen: %s", err))
+	for _, category := range topicCategories {
+		err := listener.Listen(string(category))
+		if err != nil {
+			panic(fmt.Sprintf("pubsub: could not listen on %s: %s", category, err))
+		}
 	}
 	log.Println("pubsub: listening for notifications")
 
-	for {
-		select {
-		case msg := <-listener.Notify:
-			d.dispatch(msg.Channel, msg.Extra)
+	for msg := range listener.Notify {
+		// pq sends a nil notification after re-establishing a dropped connection
+		if msg == nil {
+			continue
 		}
+		d.dispatch(msg.Channel, msg.Extra)
 	}
 
 	log.Printf("pubsub: terminating pg listener")
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"github.com/lib/pq"
+	"github.com/tengen-io/server/models"
+	"time"
+)
+
+// CreateBot creates a user with no identity, whose moves are played by a
+// bot.
+func (r *Repository) CreateBot(name string, botType models.BotType, command string) (*models.Bot, error) {
+	rv := models.Bot{
+		Type:    botType,
+		Command: command,
+	}
+	ts := pq.FormatTimestamp(time.Now().UTC())
+
+	row := r.handle().QueryRowx("INSERT INTO users (name, created_at, updated_at) VALUES ($1, $2, $3) RETURNING id, name", name, ts, ts)
+	err := row.Scan(&rv.User.Id, &rv.User.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.handle().Exec("INSERT INTO bots (user_id, type, command, created_at) VALUES ($1, $2, $3, $4)", rv.User.Id, botType, command, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rv, nil
+}
+
+// GetBotGames returns the games in play or being scored that have a bot
+// seated as a player.
+func (r *Repository) GetBotGames() ([]models.BotGame, error) {
+	rows, err := r.handle().Query("SELECT g.id, gu.index, u.id, u.name, b.type, b.command FROM games g, game_user gu, users u, bots b WHERE gu.game_id = g.id AND gu.user_id = u.id AND b.user_id = u.id AND gu.type = $1 AND g.state IN ($2, $3, $4) ORDER BY g.id", models.GameUserEdgeTypePlayer, models.GameStateNegotiation, models.GameStateInProgress, models.GameStateScoring)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]models.BotGame, 0)
+	for rows.Next() {
+		var i models.BotGame
+		err := rows.Scan(&i.GameId, &i.Index, &i.Bot.User.Id, &i.Bot.User.Name, &i.Bot.Type, &i.Bot.Command)
+		if err != nil {
+			return nil, err
+		}
+
+		rv = append(rv, i)
+	}
+
+	return rv, nil
+}
+
+// GetBots returns the bots of the given type.
+func (r *Repository) GetBots(botType models.BotType) ([]models.Bot, error) {
+	rows, err := r.handle().Query("SELECT u.id, u.name, b.type, b.command FROM users u, bots b WHERE b.user_id = u.id AND b.type = $1 ORDER BY u.id", botType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]models.Bot, 0)
+	for rows.Next() {
+		var i models.Bot
+		err := rows.Scan(&i.User.Id, &i.User.Name, &i.Type, &i.Command)
+		if err != nil {
+			return nil, err
+		}
+
+		rv = append(rv, i)
+	}
+
+	return rv, nil
+}
+
+// GetOpenChallenges returns the challenges on boards up to maxBoardSize that
+// were opened before the given time and nobody has accepted yet.
+func (r *Repository) GetOpenChallenges(maxBoardSize int, openedBefore time.Time) ([]*models.Game, error) {
+	rows, err := r.handle().Queryx("SELECT g.* FROM games g WHERE g.type = $1 AND g.state = $2 AND g.board_size <= $3 AND g.created_at <= $4 AND (SELECT count(*) FROM game_user gu WHERE gu.game_id = g.id AND gu.type = $5) = 1 ORDER BY g.id", models.GameTypeStandard, models.GameStateNegotiation, maxBoardSize, pq.FormatTimestamp(openedBefore.UTC()), models.GameUserEdgeTypePlayer)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]*models.Game, 0)
+	for rows.Next() {
+		var i models.Game
+		err := rows.StructScan(&i)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, &i)
+	}
+
+	return rv, nil
+}
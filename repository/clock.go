@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/lib/pq"
+	"github.com/tengen-io/server/models"
+	"strconv"
+	"time"
+)
+
+func (r *Repository) CreateTimeControl(gameId string, tc models.TimeControl) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("INSERT INTO time_controls (game_id, system, main_time, increment, max_time, periods, period_time, period_moves, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)", gameId, tc.System, tc.MainTime, tc.Increment, tc.MaxTime, tc.Periods, tc.PeriodTime, tc.PeriodMoves, ts)
+	return err
+}
+
+// GetTimeControl returns the time control of the game, or nil if the game is
+// untimed.
+func (r *Repository) GetTimeControl(gameId string) (*models.TimeControl, error) {
+	idInt, err := strconv.Atoi(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	var rv models.TimeControl
+	row := r.handle().QueryRowx("SELECT system, main_time, increment, max_time, periods, period_time, period_moves FROM time_controls WHERE game_id = $1", idInt)
+	err = row.StructScan(&rv)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rv, nil
+}
+
+func (r *Repository) GetClocks(gameId string) ([]models.Clock, error) {
+	idInt, err := strconv.Atoi(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.handle().Query("SELECT index, main_time, periods, period_time, period_moves, running_since FROM clocks WHERE game_id = $1 ORDER BY index", idInt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]models.Clock, 0)
+	for rows.Next() {
+		var c models.Clock
+		var mainTime, periodTime int64
+		err := rows.Scan(&c.Index, &mainTime, &c.Periods, &periodTime, &c.PeriodMoves, &c.RunningSince)
+		if err != nil {
+			return nil, err
+		}
+
+		c.MainTime = time.Duration(mainTime) * time.Millisecond
+		c.PeriodTime = time.Duration(periodTime) * time.Millisecond
+		rv = append(rv, c)
+	}
+
+	return rv, nil
+}
+
+// SaveClock stores the clock of the player at c.Index. Times are kept to the
+// millisecond.
+func (r *Repository) SaveClock(gameId string, c models.Clock) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+
+	var runningSince interface{}
+	if c.RunningSince != nil {
+		runningSince = pq.FormatTimestamp(c.RunningSince.UTC())
+	}
+
+	_, err := r.handle().Exec("INSERT INTO clocks (game_id, index, main_time, periods, period_time, period_moves, running_since, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (game_id, index) DO UPDATE SET main_time = EXCLUDED.main_time, periods = EXCLUDED.periods, period_time = EXCLUDED.period_time, period_moves = EXCLUDED.period_moves, running_since = EXCLUDED.running_since, updated_at = EXCLUDED.updated_at",
+		gameId, c.Index, c.MainTime/time.Millisecond, c.Periods, c.PeriodTime/time.Millisecond, c.PeriodMoves, runningSince, ts)
+	return err
+}
+
+// GetRunningClocks returns the clocks that are counting down in games that
+// are still being played.
+func (r *Repository) GetRunningClocks() ([]models.RunningClock, error) {
+	rows, err := r.handle().Query("SELECT c.game_id, c.index, c.main_time, c.periods, c.period_time, c.period_moves, c.running_since, t.system, t.main_time, t.increment, t.max_time, t.periods, t.period_time, t.period_moves FROM clocks c, time_controls t, games g WHERE c.game_id = t.game_id AND c.game_id = g.id AND c.running_since IS NOT NULL AND g.state IN ($1, $2)", models.GameStateNegotiation, models.GameStateInProgress)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]models.RunningClock, 0)
+	for rows.Next() {
+		var i models.RunningClock
+		var mainTime, periodTime int64
+		tc := &i.TimeControl
+		err := rows.Scan(&i.GameId, &i.Clock.Index, &mainTime, &i.Clock.Periods, &periodTime, &i.Clock.PeriodMoves, &i.Clock.RunningSince,
+			&tc.System, &tc.MainTime, &tc.Increment, &tc.MaxTime, &tc.Periods, &tc.PeriodTime, &tc.PeriodMoves)
+		if err != nil {
+			return nil, err
+		}
+
+		i.Clock.MainTime = time.Duration(mainTime) * time.Millisecond
+		i.Clock.PeriodTime = time.Duration(periodTime) * time.Millisecond
+		rv = append(rv, i)
+	}
+
+	return rv, nil
+}
+
+// SaveClockHistory remembers the clock the mover had before the move with the
+// given number, so that it can be given back if the move is taken back.
+func (r *Repository) SaveClockHistory(gameId string, number int, c models.Clock) error {
+	_, err := r.handle().Exec("INSERT INTO clock_history (game_id, number, index, main_time, periods, period_time, period_moves) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+		gameId, number, c.Index, c.MainTime/time.Millisecond, c.Periods, c.PeriodTime/time.Millisecond, c.PeriodMoves)
+	return err
+}
+
+// TakeClockHistory removes and returns the clock saved for the move with the
+// given number, or nil if there is none.
+func (r *Repository) TakeClockHistory(gameId string, number int) (*models.Clock, error) {
+	var c models.Clock
+	var mainTime, periodTime int64
+	row := r.handle().QueryRowx("DELETE FROM clock_history WHERE game_id = $1 AND number = $2 RETURNING index, main_time, periods, period_time, period_moves", gameId, number)
+	err := row.Scan(&c.Index, &mainTime, &c.Periods, &periodTime, &c.PeriodMoves)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.MainTime = time.Duration(mainTime) * time.Millisecond
+	c.PeriodTime = time.Duration(periodTime) * time.Millisecond
+	return &c, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"database/sql"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"github.com/tengen-io/server/models"
@@ -10,7 +11,7 @@ import (
 
 // TODO(eac): Add validation
 // TODO(eac): Switch to sqlx binding
-func (r *Repository) CreateGame(gameType models.GameType, boardSize int, gameState models.GameState, users []models.User) (*models.Game, error) {
+func (r *Repository) CreateGame(gameType models.GameType, settings models.GameSettings, gameState models.GameState, users []models.User) (*models.Game, error) {
 	var tx *sqlx.Tx
 	if r.tx == nil {
 		t, err := r.db.Beginx()
@@ -27,19 +28,19 @@ func (r *Repository) CreateGame(gameType models.GameType, boardSize int, gameSta
 	var rv models.Game
 	ts := pq.FormatTimestamp(time.Now().UTC())
 
-	game := tx.QueryRow("INSERT INTO games (type, state, board_size, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) RETURNING id, type, state, board_size", gameType, gameState, boardSize, ts, ts)
-	err := game.Scan(&rv.Id, &rv.Type, &rv.State, &rv.BoardSize)
+	game := tx.QueryRow("INSERT INTO games (type, state, board_size, ruleset, handicap, handicap_placement, komi, undo_allowed, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id, type, state, board_size, ruleset, handicap, handicap_placement, komi, undo_allowed", gameType, gameState, settings.BoardSize, settings.Ruleset, settings.Handicap, settings.HandicapPlacement, settings.Komi, settings.UndoAllowed, ts, ts)
+	err := game.Scan(&rv.Id, &rv.Type, &rv.State, &rv.BoardSize, &rv.Ruleset, &rv.Handicap, &rv.HandicapPlacement, &rv.Komi, &rv.UndoAllowed)
 	if err != nil {
 		return nil, err
 	}
 
-	insertStmt, err := tx.Prepare("INSERT INTO game_user (game_id, user_id, type, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)")
+	insertStmt, err := tx.Prepare("INSERT INTO game_user (game_id, user_id, type, index, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)")
 	if err != nil {
 		return nil, err
 	}
 
-	for _, user := range users {
-		_, err = insertStmt.Exec(rv.Id, user.Id, models.GameUserEdgeTypePlayer, ts, ts)
+	for i, user := range users {
+		_, err = insertStmt.Exec(rv.Id, user.Id, models.GameUserEdgeTypePlayer, i, ts, ts)
 		if err != nil {
 			return nil, err
 		}
@@ -97,13 +98,14 @@ func (r *Repository) CreateGameUser(gameId string, userId string, edgeType model
 
 	var rv models.Game
 	ts := pq.FormatTimestamp(time.Now().UTC())
-	_, err = tx.Exec("INSERT INTO game_user (game_id, user_id, type, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)", gameId, userId, edgeType, ts, ts)
+	_, err = tx.Exec("INSERT INTO game_user (game_id, user_id, type, index, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)", gameId, userId, edgeType, len(gameUsers), ts, ts)
 	if err != nil {
 		return nil, err
 	}
 
 	newEdge := models.GameUserEdge{
-		Type: edgeType,
+		Index: len(gameUsers),
+		Type:  edgeType,
 		User: models.User{
 			NodeFields: models.NodeFields{
 				Id: userId,
@@ -128,6 +130,43 @@ func (r *Repository) CreateGameUser(gameId string, userId string, edgeType model
 	return &rv, nil
 }
 
+// CreateImportedGame records a finished game that was played elsewhere. user
+// is attached to it at index with edgeType: as a player if they took part in
+// the game, or as its owner otherwise. The game is dated from started to
+// finished, or from now where they are zero.
+func (r *Repository) CreateImportedGame(settings models.GameSettings, result *string, blackName string, whiteName string, started time.Time, finished time.Time, user models.User, edgeType models.GameUserEdgeType, index int) (*models.Game, error) {
+	var rv models.Game
+	now := time.Now().UTC()
+	ts := pq.FormatTimestamp(now)
+
+	if started.IsZero() {
+		started = now
+	}
+	if finished.IsZero() {
+		finished = started
+	}
+
+	row := r.handle().QueryRowx("INSERT INTO games (type, state, board_size, ruleset, handicap, handicap_placement, komi, result, black_name, white_name, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *", models.GameTypeImported, models.GameStateFinished, settings.BoardSize, settings.Ruleset, settings.Handicap, settings.HandicapPlacement, settings.Komi, result, blackName, whiteName, pq.FormatTimestamp(started.UTC()), pq.FormatTimestamp(finished.UTC()))
+	err := row.StructScan(&rv)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.handle().Exec("INSERT INTO game_user (game_id, user_id, type, index, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)", rv.Id, user.Id, edgeType, index, ts, ts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rv, nil
+}
+
+// AddGamePlayer seats user in the game at index, which decides their color.
+func (r *Repository) AddGamePlayer(gameId string, user models.User, index int) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("INSERT INTO game_user (game_id, user_id, type, index, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)", gameId, user.Id, models.GameUserEdgeTypePlayer, index, ts, ts)
+	return err
+}
+
 func (r *Repository) GetGameById(id string) (*models.Game, error) {
 	idInt, err := strconv.Atoi(id)
 	if err != nil {
@@ -144,13 +183,73 @@ func (r *Repository) GetGameById(id string) (*models.Game, error) {
 	return &game, nil
 }
 
+// GetGameByIdForUpdate locks the game row until the surrounding transaction
+// ends, serializing moves and state changes made against the same game.
+func (r *Repository) GetGameByIdForUpdate(id string) (*models.Game, error) {
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var game models.Game
+	row := r.handle().QueryRowx("SELECT * FROM games WHERE id = $1 FOR UPDATE", idInt)
+	err = row.StructScan(&game)
+	if err != nil {
+		return nil, err
+	}
+
+	return &game, nil
+}
+
+// TryLockGame is GetGameByIdForUpdate for background workers. It returns nil
+// instead of waiting if the game is already locked by someone else.
+func (r *Repository) TryLockGame(id string) (*models.Game, error) {
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var game models.Game
+	row := r.handle().QueryRowx("SELECT * FROM games WHERE id = $1 FOR UPDATE SKIP LOCKED", idInt)
+	err = row.StructScan(&game)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &game, nil
+}
+
+func (r *Repository) UpdateGameState(id string, state models.GameState) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("UPDATE games SET state = $1, updated_at = $2 WHERE id = $3", state, ts, id)
+	return err
+}
+
+// SetUndoRequest records that a takeback of the move with the given number
+// was asked for, or clears the request if number is nil.
+func (r *Repository) SetUndoRequest(id string, number *int) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("UPDATE games SET undo_request = $1, updated_at = $2 WHERE id = $3", number, ts, id)
+	return err
+}
+
+// FinishGame records the result of a game and moves it to FINISHED.
+func (r *Repository) FinishGame(id string, result string) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("UPDATE games SET state = $1, result = $2, updated_at = $3 WHERE id = $4", models.GameStateFinished, result, ts, id)
+	return err
+}
+
 func (r *Repository) GetUsersForGame(id string) ([]models.GameUserEdge, error) {
 	idInt, err := strconv.Atoi(id)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := r.db.Query("SELECT type, user_id, name FROM game_user gu, users u WHERE game_id = $1 AND gu.user_id = u.id", idInt)
+	rows, err := r.handle().Query("SELECT gu.index, type, user_id, name, score_accepted FROM game_user gu, users u WHERE game_id = $1 AND gu.user_id = u.id ORDER BY gu.index", idInt)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +258,7 @@ func (r *Repository) GetUsersForGame(id string) ([]models.GameUserEdge, error) {
 	var rv = make([]models.GameUserEdge, 0)
 	for rows.Next() {
 		var i models.GameUserEdge
-		err := rows.Scan(&i.Type, &i.User.Id, &i.User.Name)
+		err := rows.Scan(&i.Index, &i.Type, &i.User.Id, &i.User.Name, &i.ScoreAccepted)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"github.com/lib/pq"
+	"github.com/tengen-io/server/models"
+	"strconv"
+	"time"
+)
+
+func (r *Repository) GetHandicapStones(gameId string) ([]models.Point, error) {
+	idInt, err := strconv.Atoi(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.handle().Query("SELECT x, y FROM handicap_stones WHERE game_id = $1 ORDER BY y, x", idInt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]models.Point, 0)
+	for rows.Next() {
+		var p models.Point
+		err := rows.Scan(&p.X, &p.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		rv = append(rv, p)
+	}
+
+	return rv, nil
+}
+
+func (r *Repository) AddHandicapStones(gameId string, points []models.Point) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	stmt, err := r.handle().Prepare("INSERT INTO handicap_stones (game_id, x, y, created_at) VALUES ($1, $2, $3, $4)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, p := range points {
+		_, err = stmt.Exec(gameId, p.X, p.Y, ts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
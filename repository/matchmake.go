@@ -8,7 +8,7 @@ import (
 )
 
 func (r *Repository) GetMatchmakingRequests() ([]models.MatchmakingRequest, error) {
-	rows, err := r.db.Query("SELECT id, user_id, rank, rank_delta, created_at, updated_at FROM matchmake_requests")
+	rows, err := r.db.Query("SELECT id, user_id, rank, rank_delta, ruleset, created_at, updated_at FROM matchmake_requests")
 	if err != nil {
 		return nil, err
 	}
@@ -18,7 +18,7 @@ func (r *Repository) GetMatchmakingRequests() ([]models.MatchmakingRequest, erro
 	requests := make([]models.MatchmakingRequest, 0)
 	for rows.Next() {
 		var request models.MatchmakingRequest
-		err := rows.Scan(&request.Id, &request.User.Id, &request.Rank, &request.Delta, &request.CreatedAt, &request.UpdatedAt)
+		err := rows.Scan(&request.Id, &request.User.Id, &request.Rank, &request.Delta, &request.Ruleset, &request.CreatedAt, &request.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -30,7 +30,7 @@ func (r *Repository) GetMatchmakingRequests() ([]models.MatchmakingRequest, erro
 }
 
 func (r *Repository) GetMatchmakingRequestsForUser(user models.User) ([]models.MatchmakingRequest, error) {
-	rows, err := r.db.Query("SELECT id, user_id, rank, rank_delta, created_at, updated_at FROM matchmake_requests WHERE user_id = $1", user.Id)
+	rows, err := r.db.Query("SELECT id, user_id, rank, rank_delta, ruleset, created_at, updated_at FROM matchmake_requests WHERE user_id = $1", user.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +40,7 @@ func (r *Repository) GetMatchmakingRequestsForUser(user models.User) ([]models.M
 	requests := make([]models.MatchmakingRequest, 0)
 	for rows.Next() {
 		var request models.MatchmakingRequest
-		err := rows.Scan(&request.Id, &request.User.Id, &request.Rank, &request.Delta, &request.CreatedAt, &request.UpdatedAt)
+		err := rows.Scan(&request.Id, &request.User.Id, &request.Rank, &request.Delta, &request.Ruleset, &request.CreatedAt, &request.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -51,12 +51,12 @@ func (r *Repository) GetMatchmakingRequestsForUser(user models.User) ([]models.M
 	return requests, nil
 }
 
-func (r *Repository) CreateMatchmakingRequest(user models.User, delta int) (*models.MatchmakingRequest, error) {
+func (r *Repository) CreateMatchmakingRequest(user models.User, delta int, ruleset models.Ruleset) (*models.MatchmakingRequest, error) {
 	now := time.Now().UTC()
 	ts := pq.FormatTimestamp(now)
 
 	// TODO(eac): add real ranks and queues
-	row := r.db.QueryRowx("INSERT INTO matchmake_requests (queue, user_id, rank, rank_delta, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id", "FIXME", user.Id, 10, delta, ts, ts)
+	row := r.db.QueryRowx("INSERT INTO matchmake_requests (queue, user_id, rank, rank_delta, ruleset, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id", "FIXME", user.Id, 10, delta, ruleset, ts, ts)
 	var id int64
 	err := row.Scan(&id)
 	if err != nil {
@@ -72,6 +72,7 @@ func (r *Repository) CreateMatchmakingRequest(user models.User, delta int) (*mod
 		Delta: delta,
 		Rank: 10,
 		Queue: "FIXME",
+		Ruleset: ruleset,
 	}, nil
 
 }
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"github.com/lib/pq"
+	"github.com/tengen-io/server/models"
+	"strconv"
+	"time"
+)
+
+func (r *Repository) CreateMove(gameId string, user models.User, number int, moveType models.MoveType, x *int, y *int) (*models.Move, error) {
+	now := time.Now().UTC()
+	ts := pq.FormatTimestamp(now)
+
+	row := r.handle().QueryRowx("INSERT INTO moves (game_id, user_id, number, type, x, y, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id", gameId, user.Id, number, moveType, x, y, ts, ts)
+	var id int64
+	err := row.Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Move{
+		NodeFields: models.NodeFields{
+			Id:        strconv.FormatInt(id, 10),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Type:   moveType,
+		Number: number,
+		X:      x,
+		Y:      y,
+		User:   user,
+	}, nil
+}
+
+func (r *Repository) GetMovesForGame(gameId string) ([]models.Move, error) {
+	return r.GetMovesForGameAfter(gameId, 0)
+}
+
+// GetMovesForGameAfter returns the moves of a game that come after the move
+// with the given number.
+func (r *Repository) GetMovesForGameAfter(gameId string, number int) ([]models.Move, error) {
+	idInt, err := strconv.Atoi(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.handle().Query("SELECT m.id, m.type, m.number, m.x, m.y, m.user_id, u.name, m.created_at, m.updated_at FROM moves m, users u WHERE m.game_id = $1 AND m.number > $2 AND m.user_id = u.id ORDER BY m.number", idInt, number)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]models.Move, 0)
+	for rows.Next() {
+		var m models.Move
+		err := rows.Scan(&m.Id, &m.Type, &m.Number, &m.X, &m.Y, &m.User.Id, &m.User.Name, &m.CreatedAt, &m.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		rv = append(rv, m)
+	}
+
+	return rv, nil
+}
+
+func (r *Repository) GetMoveById(id string) (*models.Move, error) {
+	idInt, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var m models.Move
+	row := r.handle().QueryRowx("SELECT m.id, m.type, m.number, m.x, m.y, m.user_id, u.name, m.created_at, m.updated_at FROM moves m, users u WHERE m.id = $1 AND m.user_id = u.id", idInt)
+	err = row.Scan(&m.Id, &m.Type, &m.Number, &m.X, &m.Y, &m.User.Id, &m.User.Name, &m.CreatedAt, &m.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// DeleteMove takes back the move with the given number.
+func (r *Repository) DeleteMove(gameId string, number int) error {
+	_, err := r.handle().Exec("DELETE FROM moves WHERE game_id = $1 AND number = $2", gameId, number)
+	return err
+}
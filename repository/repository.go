@@ -3,6 +3,7 @@ package repository
 import (
 	"encoding/json"
 	"github.com/jmoiron/sqlx"
+	"github.com/tengen-io/server/db"
 	"github.com/tengen-io/server/pubsub"
 )
 
@@ -25,6 +26,8 @@ func (r *Repository) WithTx(f func(r *Repository) error) error {
 		return err
 	}
 
+	defer tx.Rollback()
+
 	rTx := &Repository{
 		db: r.db,
 		tx: tx,
@@ -42,6 +45,16 @@ func (r *Repository) WithTx(f func(r *Repository) error) error {
 	return nil
 }
 
+// handle returns the open transaction if there is one, so reads made inside
+// WithTx see the writes (and row locks) made earlier in the same transaction.
+func (r *Repository) handle() db.Handle {
+	if r.tx != nil {
+		return r.tx
+	}
+
+	return r.db
+}
+
 func (r *Repository) Publish(topic pubsub.TopicCategory, payload pubsub.Event) error {
 	var tx *sqlx.Tx
 	if r.tx == nil {
@@ -79,3 +92,7 @@ func (r *Repository) Publish(topic pubsub.TopicCategory, payload pubsub.Event) e
 func (r *Repository) Subscribe(topic pubsub.Topic) <-chan pubsub.Event {
 	return r.pubsub.Subscribe(topic)
 }
+
+func (r *Repository) Unsubscribe(topic pubsub.Topic, c <-chan pubsub.Event) {
+	r.pubsub.Unsubscribe(topic, c)
+}
@@ -5,7 +5,9 @@ import (
 	"github.com/tengen-io/server/models"
 	"github.com/tengen-io/server/test"
 	"golang.org/x/crypto/bcrypt"
+	"math"
 	"testing"
+	"time"
 )
 
 func TestRepository_GetGamesByIds(t *testing.T) {
@@ -52,7 +54,7 @@ func TestRepository_CreateGameUserStateChange(t *testing.T) {
 	user, err := r.GetUserById("1")
 	assert.NoError(t, err)
 
-	game, err := r.CreateGame(models.GameTypeStandard, 19, models.GameStateNegotiation, []models.User{id.User})
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 19, Ruleset: models.RulesetChinese}, models.GameStateNegotiation, []models.User{id.User})
 	assert.NoError(t, err)
 	assert.Equal(t, game.State, models.GameStateNegotiation)
 
@@ -71,7 +73,7 @@ func TestRepository_CreateInvitation(t *testing.T) {
 		},
 	}
 
-	res, err := r.CreateGame(models.GameTypeStandard, 19, models.GameStateNegotiation, []models.User{identity.User})
+	res, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 19, Ruleset: models.RulesetChinese}, models.GameStateNegotiation, []models.User{identity.User})
 
 	assert.NoError(t, err)
 	assert.Equal(t, models.GameTypeStandard, res.Type)
@@ -79,6 +81,299 @@ func TestRepository_CreateInvitation(t *testing.T) {
 	assert.Equal(t, 19, res.BoardSize)
 }
 
+func TestRepository_CreateMove(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	user, err := r.GetUserById("1")
+	assert.NoError(t, err)
+
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 19, Ruleset: models.RulesetChinese}, models.GameStateNegotiation, []models.User{*user})
+	assert.NoError(t, err)
+
+	x, y := 3, 15
+	move, err := r.CreateMove(game.Id, *user, 1, models.MoveTypeStone, &x, &y)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, move.Number)
+
+	_, err = r.CreateMove(game.Id, *user, 2, models.MoveTypePass, nil, nil)
+	assert.NoError(t, err)
+
+	moves, err := r.GetMovesForGame(game.Id)
+	assert.NoError(t, err)
+	assert.Len(t, moves, 2)
+	assert.Equal(t, models.MoveTypeStone, moves[0].Type)
+	assert.Equal(t, 3, *moves[0].X)
+	assert.Equal(t, 15, *moves[0].Y)
+	assert.Equal(t, "Test User 1", moves[0].User.Name)
+	assert.Equal(t, models.MoveTypePass, moves[1].Type)
+	assert.Nil(t, moves[1].X)
+}
+
+func TestRepository_FinishGame(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese}, models.GameStateInProgress, []models.User{})
+	assert.NoError(t, err)
+
+	err = r.FinishGame(game.Id, "B+3.5")
+	assert.NoError(t, err)
+
+	game, err = r.GetGameById(game.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.GameStateFinished, game.State)
+	assert.Equal(t, "B+3.5", *game.Result)
+}
+
+func TestRepository_CreateImportedGame(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	user, err := r.GetUserById("1")
+	assert.NoError(t, err)
+
+	result := "W+R"
+	started := time.Date(1846, 9, 11, 0, 0, 0, 0, time.UTC)
+	finished := time.Date(1846, 9, 15, 0, 0, 0, 0, time.UTC)
+	game, err := r.CreateImportedGame(models.GameSettings{BoardSize: 19, Ruleset: models.RulesetJapanese}, &result, "Honinbo Shusaku", "Gennan Inseki", started, finished, *user, models.GameUserEdgeTypeOwner, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, models.GameTypeImported, game.Type)
+	assert.Equal(t, models.GameStateFinished, game.State)
+	assert.Equal(t, "Honinbo Shusaku", *game.BlackName)
+	assert.True(t, started.Equal(game.CreatedAt))
+	assert.True(t, finished.Equal(game.UpdatedAt))
+
+	users, err := r.GetUsersForGame(game.Id)
+	assert.NoError(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, models.GameUserEdgeTypeOwner, users[0].Type)
+}
+
+func TestRepository_HandicapStones(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	user, err := r.GetUserById("1")
+	assert.NoError(t, err)
+
+	settings := models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese, Handicap: 2, HandicapPlacement: models.HandicapPlacementFree, Komi: 0.5}
+	game, err := r.CreateGame(models.GameTypeStandard, settings, models.GameStateNegotiation, []models.User{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, game.Handicap)
+	assert.Equal(t, models.HandicapPlacementFree, game.HandicapPlacement)
+	assert.Equal(t, 0.5, game.Komi)
+
+	err = r.AddGamePlayer(game.Id, *user, 1)
+	assert.NoError(t, err)
+
+	users, err := r.GetUsersForGame(game.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, users[0].Index)
+
+	err = r.AddHandicapStones(game.Id, []models.Point{{X: 6, Y: 6}, {X: 2, Y: 2}})
+	assert.NoError(t, err)
+
+	stones, err := r.GetHandicapStones(game.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Point{{X: 2, Y: 2}, {X: 6, Y: 6}}, stones)
+}
+
+func TestRepository_Clocks(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese}, models.GameStateNegotiation, []models.User{})
+	assert.NoError(t, err)
+
+	tc, err := r.GetTimeControl(game.Id)
+	assert.NoError(t, err)
+	assert.Nil(t, tc)
+
+	err = r.CreateTimeControl(game.Id, models.TimeControl{System: models.TimeControlSystemByoYomi, MainTime: 600, Periods: 5, PeriodTime: 30})
+	assert.NoError(t, err)
+
+	tc, err = r.GetTimeControl(game.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, models.TimeControlSystemByoYomi, tc.System)
+	assert.Equal(t, 30, tc.PeriodTime)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	err = r.SaveClock(game.Id, models.Clock{Index: 0, MainTime: 10 * time.Minute, Periods: 5, PeriodTime: 30 * time.Second})
+	assert.NoError(t, err)
+	err = r.SaveClock(game.Id, models.Clock{Index: 0, MainTime: 9500 * time.Millisecond, Periods: 5, PeriodTime: 30 * time.Second, RunningSince: &now})
+	assert.NoError(t, err)
+
+	clocks, err := r.GetClocks(game.Id)
+	assert.NoError(t, err)
+	assert.Len(t, clocks, 1)
+	assert.Equal(t, 9500*time.Millisecond, clocks[0].MainTime)
+	assert.True(t, now.Equal(*clocks[0].RunningSince))
+}
+
+func TestRepository_Undo(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	user, err := r.GetUserById("1")
+	assert.NoError(t, err)
+
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese, UndoAllowed: true}, models.GameStateInProgress, []models.User{*user})
+	assert.NoError(t, err)
+	assert.True(t, game.UndoAllowed)
+
+	x, y := 4, 4
+	_, err = r.CreateMove(game.Id, *user, 1, models.MoveTypeStone, &x, &y)
+	assert.NoError(t, err)
+
+	err = r.SaveClockHistory(game.Id, 1, models.Clock{Index: 0, MainTime: 90 * time.Second})
+	assert.NoError(t, err)
+
+	number := 1
+	err = r.SetUndoRequest(game.Id, &number)
+	assert.NoError(t, err)
+
+	game, err = r.GetGameById(game.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *game.UndoRequest)
+
+	err = r.DeleteMove(game.Id, 1)
+	assert.NoError(t, err)
+
+	moves, err := r.GetMovesForGame(game.Id)
+	assert.NoError(t, err)
+	assert.Len(t, moves, 0)
+
+	c, err := r.TakeClockHistory(game.Id, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, c.MainTime)
+
+	c, err = r.TakeClockHistory(game.Id, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+}
+
+func TestRepository_Snapshots(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese}, models.GameStateInProgress, []models.User{})
+	assert.NoError(t, err)
+
+	number, data, err := r.GetLatestSnapshot(game.Id, math.MaxInt32)
+	assert.NoError(t, err)
+	assert.Nil(t, data)
+
+	err = r.SaveSnapshot(game.Id, 50, []byte{1, 2})
+	assert.NoError(t, err)
+	err = r.SaveSnapshot(game.Id, 100, []byte{3, 4})
+	assert.NoError(t, err)
+
+	number, data, err = r.GetLatestSnapshot(game.Id, math.MaxInt32)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, number)
+	assert.Equal(t, []byte{3, 4}, data)
+
+	number, data, err = r.GetLatestSnapshot(game.Id, 99)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, number)
+	assert.Equal(t, []byte{1, 2}, data)
+
+	err = r.DeleteSnapshots(game.Id, 100)
+	assert.NoError(t, err)
+
+	number, _, err = r.GetLatestSnapshot(game.Id, math.MaxInt32)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, number)
+}
+
+func TestRepository_Bots(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	user, err := r.GetUserById("1")
+	assert.NoError(t, err)
+
+	bot, err := r.CreateBot("Test Bot GTP", models.BotTypeGTP, "gnugo --mode gtp")
+	assert.NoError(t, err)
+	assert.Equal(t, "Test Bot GTP", bot.User.Name)
+
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese}, models.GameStateInProgress, []models.User{*user, bot.User})
+	assert.NoError(t, err)
+
+	games, err := r.GetBotGames()
+	assert.NoError(t, err)
+
+	found := false
+	for _, bg := range games {
+		if bg.GameId == game.Id {
+			found = true
+			assert.Equal(t, 1, bg.Index)
+			assert.Equal(t, bot.User.Id, bg.Bot.User.Id)
+			assert.Equal(t, "gnugo --mode gtp", bg.Bot.Command)
+		}
+	}
+	assert.True(t, found)
+
+	builtin, err := r.GetBots(models.BotTypeBuiltin)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, builtin)
+	for _, b := range builtin {
+		assert.Equal(t, models.BotTypeBuiltin, b.Type)
+	}
+
+	small, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese}, models.GameStateNegotiation, []models.User{*user})
+	assert.NoError(t, err)
+	large, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 19, Ruleset: models.RulesetChinese}, models.GameStateNegotiation, []models.User{*user})
+	assert.NoError(t, err)
+
+	challenges, err := r.GetOpenChallenges(13, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+
+	ids := make(map[string]bool)
+	for _, g := range challenges {
+		ids[g.Id] = true
+	}
+	assert.True(t, ids[small.Id])
+	assert.False(t, ids[large.Id])
+	assert.False(t, ids[game.Id])
+
+	challenges, err = r.GetOpenChallenges(13, time.Now().Add(-time.Minute))
+	assert.NoError(t, err)
+	for _, g := range challenges {
+		assert.NotEqual(t, small.Id, g.Id)
+	}
+}
+
+func TestRepository_DeadStones(t *testing.T) {
+	r := NewRepository(test.DB(), test.PubSub())
+
+	user, err := r.GetUserById("1")
+	assert.NoError(t, err)
+
+	game, err := r.CreateGame(models.GameTypeStandard, models.GameSettings{BoardSize: 9, Ruleset: models.RulesetChinese}, models.GameStateScoring, []models.User{*user})
+	assert.NoError(t, err)
+
+	err = r.AddDeadStones(game.Id, []models.Point{{X: 1, Y: 1}, {X: 1, Y: 2}})
+	assert.NoError(t, err)
+
+	err = r.RemoveDeadStones(game.Id, []models.Point{{X: 1, Y: 1}})
+	assert.NoError(t, err)
+
+	dead, err := r.GetDeadStones(game.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Point{{X: 1, Y: 2}}, dead)
+
+	err = r.SetScoreAccepted(game.Id, user.Id, true)
+	assert.NoError(t, err)
+
+	users, err := r.GetUsersForGame(game.Id)
+	assert.NoError(t, err)
+	assert.True(t, users[0].ScoreAccepted)
+
+	err = r.ResetScoreAccepted(game.Id)
+	assert.NoError(t, err)
+
+	err = r.ClearDeadStones(game.Id)
+	assert.NoError(t, err)
+
+	dead, err = r.GetDeadStones(game.Id)
+	assert.NoError(t, err)
+	assert.Len(t, dead, 0)
+}
+
 func TestRepository_GetIdentityById(t *testing.T) {
 	r := NewRepository(test.DB(), test.PubSub())
 
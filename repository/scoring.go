@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"github.com/lib/pq"
+	"github.com/tengen-io/server/models"
+	"strconv"
+	"time"
+)
+
+func (r *Repository) GetDeadStones(gameId string) ([]models.Point, error) {
+	idInt, err := strconv.Atoi(gameId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.handle().Query("SELECT x, y FROM dead_stones WHERE game_id = $1 ORDER BY y, x", idInt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rv := make([]models.Point, 0)
+	for rows.Next() {
+		var p models.Point
+		err := rows.Scan(&p.X, &p.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		rv = append(rv, p)
+	}
+
+	return rv, nil
+}
+
+func (r *Repository) AddDeadStones(gameId string, points []models.Point) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	stmt, err := r.handle().Prepare("INSERT INTO dead_stones (game_id, x, y, created_at) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, p := range points {
+		_, err = stmt.Exec(gameId, p.X, p.Y, ts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) RemoveDeadStones(gameId string, points []models.Point) error {
+	stmt, err := r.handle().Prepare("DELETE FROM dead_stones WHERE game_id = $1 AND x = $2 AND y = $3")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, p := range points {
+		_, err = stmt.Exec(gameId, p.X, p.Y)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Repository) ClearDeadStones(gameId string) error {
+	_, err := r.handle().Exec("DELETE FROM dead_stones WHERE game_id = $1", gameId)
+	return err
+}
+
+func (r *Repository) SetScoreAccepted(gameId string, userId string, accepted bool) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("UPDATE game_user SET score_accepted = $1, updated_at = $2 WHERE game_id = $3 AND user_id = $4", accepted, ts, gameId, userId)
+	return err
+}
+
+// ResetScoreAccepted withdraws every player's acceptance of the score, which
+// is needed whenever the set of dead stones changes.
+func (r *Repository) ResetScoreAccepted(gameId string) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("UPDATE game_user SET score_accepted = false, updated_at = $1 WHERE game_id = $2", ts, gameId)
+	return err
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/lib/pq"
+	"time"
+)
+
+// SaveSnapshot stores the encoded position of a game after the move with the
+// given number.
+func (r *Repository) SaveSnapshot(gameId string, number int, data []byte) error {
+	ts := pq.FormatTimestamp(time.Now().UTC())
+	_, err := r.handle().Exec("INSERT INTO game_snapshots (game_id, number, data, created_at) VALUES ($1, $2, $3, $4) ON CONFLICT (game_id, number) DO UPDATE SET data = EXCLUDED.data, created_at = EXCLUDED.created_at", gameId, number, data, ts)
+	return err
+}
+
+// GetLatestSnapshot returns the most recent snapshot of a game taken no later
+// than the move with the given number, along with the number of the move it
+// was taken after. It returns nil if there is none.
+func (r *Repository) GetLatestSnapshot(gameId string, before int) (int, []byte, error) {
+	var number int
+	var data []byte
+	row := r.handle().QueryRowx("SELECT number, data FROM game_snapshots WHERE game_id = $1 AND number <= $2 ORDER BY number DESC LIMIT 1", gameId, before)
+	err := row.Scan(&number, &data)
+	if err == sql.ErrNoRows {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return number, data, nil
+}
+
+// DeleteSnapshots removes the snapshots taken at or after the move with the
+// given number, for when that move is taken back.
+func (r *Repository) DeleteSnapshots(gameId string, number int) error {
+	_, err := r.handle().Exec("DELETE FROM game_snapshots WHERE game_id = $1 AND number >= $2", gameId, number)
+	return err
+}